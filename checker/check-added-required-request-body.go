@@ -28,6 +28,8 @@ func AddedRequiredRequestBodyCheck(diffReport *diff.Diff, operationsSources *dif
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      source,
+
+					RevisionSource: config.getRevisionSource(requestBodyOf(operationItem.Revision), operationItem.Revision),
 				})
 			}
 		}
@@ -20,6 +20,8 @@ func APIAddedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSour
 			OperationId: opConfig.OperationID,
 			Path:        path,
 			Source:      (*operationsSources)[opConfig],
+
+			RevisionSource: config.getRevisionSource(opConfig),
 		})
 	}
 
@@ -36,6 +36,9 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -50,6 +53,9 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -66,6 +72,9 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -81,6 +90,9 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -94,6 +106,9 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 				OperationId: op.OperationID,
 				Path:        path,
 				Source:      source,
+
+				BaseSource:     config.getBaseSource(operationDiff.Base),
+				RevisionSource: config.getRevisionSource(op),
 			})
 		}
 	}
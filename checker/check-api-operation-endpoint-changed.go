@@ -0,0 +1,49 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+const apiOperationEndpointChangedId = "api-operation-endpoint-changed"
+
+// APIOperationEndpointChangedCheck checks operations which were matched by their operationId after their endpoint changed, see diff.Config.MatchOperationIds
+// changing the endpoint breaks the clients which call the old endpoint
+// the changes in the operation itself are checked like changes in an operation whose endpoint didn't change
+func APIOperationEndpointChangedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.RenamedOperationsDiff == nil {
+		return result
+	}
+
+	for operationId, renamedOperation := range *diffReport.RenamedOperationsDiff {
+		base := renamedOperation.Base
+		revision := renamedOperation.Revision
+		source := (*operationsSources)[renamedOperation.RevisionOperation]
+
+		result = append(result, BackwardCompatibilityError{
+			Id:          apiOperationEndpointChangedId,
+			Level:       config.getLogLevel(apiOperationEndpointChangedId, ERR),
+			Text:        fmt.Sprintf(config.i18n(apiOperationEndpointChangedId), ColorizedValue(operationId), ColorizedValue(base.Method+" "+base.Path), ColorizedValue(revision.Method+" "+revision.Path)),
+			Operation:   revision.Method,
+			OperationId: operationId,
+			Path:        revision.Path,
+			Source:      source,
+
+			BaseSource:     config.getBaseSource(renamedOperation.BaseOperation),
+			RevisionSource: config.getRevisionSource(renamedOperation.RevisionOperation),
+		})
+
+		if renamedOperation.MethodDiff == nil {
+			continue
+		}
+
+		for _, err := range runOperationChecks(config, operationChecks(), revision.Path, revision.Method, renamedOperation.MethodDiff) {
+			err.Source = source
+			result = append(result, err)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,45 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+)
+
+func getMatchOperationIdsConfig() *diff.Config {
+	config := getConfig()
+	config.MatchOperationIds = true
+	return config
+}
+
+// BC: changing the endpoint of an operation which is matched by its operationId is breaking
+func TestAPIOperationEndpointChanged(t *testing.T) {
+	errs := getChangesWithDiffConfig(t, getMatchOperationIdsConfig(), singleCheckConfig(checker.APIOperationEndpointChangedCheck), "../data/operation-ids/base.yaml", "../data/operation-ids/revision.yaml", nil)
+	require.Contains(t, errs, checker.BackwardCompatibilityError{
+		Id:          "api-operation-endpoint-changed",
+		Level:       checker.ERR,
+		Text:        "the endpoint of the operation 'deleteUser' was changed from 'DELETE /v1/users/{id}' to 'POST /users/{userId}/remove'",
+		Operation:   "POST",
+		OperationId: "deleteUser",
+		Path:        "/users/{userId}/remove",
+		Source:      "../data/operation-ids/revision.yaml",
+	})
+}
+
+// BC: adding a required request parameter to an operation whose endpoint changed is breaking
+func TestAPIOperationEndpointChanged_OperationChecked(t *testing.T) {
+	errs := getChangesWithDiffConfig(t, getMatchOperationIdsConfig(), checker.GetDefaultChecks(), "../data/operation-ids/base.yaml", "../data/operation-ids/revision.yaml", nil)
+	require.Contains(t, errs, checker.BackwardCompatibilityError{
+		Id:          "new-required-request-parameter",
+		Level:       checker.ERR,
+		Text:        "added the new required 'query' request parameter 'fields'",
+		Operation:   "GET",
+		OperationId: "getUser",
+		Path:        "/users/{userId}",
+		Source:      "../data/operation-ids/revision.yaml",
+
+		ParameterName: "fields",
+	})
+}
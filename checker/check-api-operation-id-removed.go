@@ -37,6 +37,9 @@ func APIOperationIdRemovedCheck(diffReport *diff.Diff, operationsSources *diff.O
 				OperationId: op.OperationID,
 				Path:        path,
 				Source:      source,
+
+				BaseSource:     config.getBaseSource(op),
+				RevisionSource: config.getRevisionSource(operationItem.Revision),
 			})
 		}
 	}
@@ -30,6 +30,8 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource: config.getBaseSource(op),
 				})
 				continue
 			}
@@ -44,6 +46,8 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource: config.getBaseSource(op),
 				})
 				continue
 			}
@@ -57,6 +61,8 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource: config.getBaseSource(op),
 				})
 			}
 		}
@@ -78,6 +84,8 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource: config.getBaseSource(op),
 				})
 				continue
 			}
@@ -92,6 +100,8 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource: config.getBaseSource(op),
 				})
 				continue
 			}
@@ -105,6 +115,8 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource: config.getBaseSource(op),
 				})
 			}
 		}
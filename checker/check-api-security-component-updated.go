@@ -0,0 +1,147 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	apiSecurityComponentAddedCheckId               = "api-security-component-added"
+	apiSecurityComponentRemovedCheckId             = "api-security-component-removed"
+	apiSecurityComponentTypeChangedCheckId         = "api-security-component-type-changed"
+	apiSecurityComponentNameChangedCheckId         = "api-security-component-name-changed"
+	apiSecurityComponentInChangedCheckId           = "api-security-component-in-changed"
+	apiSecurityComponentSchemeChangedCheckId       = "api-security-component-scheme-changed"
+	apiSecurityComponentBearerFormatChangedCheckId = "api-security-component-bearer-format-changed"
+	apiSecurityComponentOpenIdUrlChangedCheckId    = "api-security-component-openid-url-changed"
+	apiSecurityComponentOAuthFlowAddedCheckId      = "api-security-component-oauth-flow-added"
+	apiSecurityComponentOAuthFlowRemovedCheckId    = "api-security-component-oauth-flow-removed"
+	apiSecurityComponentOAuthUrlChangedCheckId     = "api-security-component-oauth-url-changed"
+	apiSecurityComponentOAuthScopeAddedCheckId     = "api-security-component-oauth-scope-added"
+	apiSecurityComponentOAuthScopeRemovedCheckId   = "api-security-component-oauth-scope-removed"
+	apiSecurityComponentSourcePrefix               = "components.securitySchemes."
+)
+
+// APISecurityComponentUpdatedCheck checks changes in the security schemes defined in the components
+func APISecurityComponentUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.ComponentsDiff.SecuritySchemesDiff == nil {
+		return result
+	}
+
+	newError := func(id string, defaultLevel Level, scheme string, args ...interface{}) BackwardCompatibilityError {
+		return BackwardCompatibilityError{
+			Id:        id,
+			Level:     config.getLogLevel(id, defaultLevel),
+			Text:      fmt.Sprintf(config.i18n(id), args...),
+			Operation: "N/A",
+			Path:      "",
+			Source:    apiSecurityComponentSourcePrefix + scheme,
+
+			BaseSource:     config.getBaseSource(load.JSONPointer("components", "securitySchemes", scheme)),
+			RevisionSource: config.getRevisionSource(load.JSONPointer("components", "securitySchemes", scheme)),
+		}
+	}
+
+	securitySchemesDiff := diffReport.ComponentsDiff.SecuritySchemesDiff
+
+	for _, scheme := range securitySchemesDiff.Added {
+		result = append(result, newError(apiSecurityComponentAddedCheckId, INFO, scheme, ColorizedValue(scheme)))
+	}
+
+	for _, scheme := range securitySchemesDiff.Deleted {
+		result = append(result, newError(apiSecurityComponentRemovedCheckId, WARN, scheme, ColorizedValue(scheme)))
+	}
+
+	for scheme, schemeDiff := range securitySchemesDiff.Modified {
+		if schemeDiff.Empty() {
+			continue
+		}
+
+		valueChecks := []struct {
+			id        string
+			level     Level
+			valueDiff *diff.ValueDiff
+		}{
+			{apiSecurityComponentTypeChangedCheckId, ERR, schemeDiff.TypeDiff},
+			{apiSecurityComponentNameChangedCheckId, ERR, schemeDiff.NameDiff},
+			{apiSecurityComponentInChangedCheckId, ERR, schemeDiff.InDiff},
+			{apiSecurityComponentSchemeChangedCheckId, ERR, schemeDiff.SchemeDiff},
+			{apiSecurityComponentBearerFormatChangedCheckId, WARN, schemeDiff.BearerFormatDiff},
+			{apiSecurityComponentOpenIdUrlChangedCheckId, WARN, schemeDiff.OpenIDConnectURLDiff},
+		}
+		for _, valueCheck := range valueChecks {
+			if valueCheck.valueDiff == nil {
+				continue
+			}
+			result = append(result, newError(valueCheck.id, valueCheck.level, scheme,
+				ColorizedValue(scheme),
+				ColorizedValue(valueCheck.valueDiff.From),
+				ColorizedValue(valueCheck.valueDiff.To)))
+		}
+
+		// when the flows object itself was added or deleted the type of the scheme changed, which is reported above
+		if schemeDiff.OAuthFlowsDiff == nil || schemeDiff.OAuthFlowsDiff.Added || schemeDiff.OAuthFlowsDiff.Deleted {
+			continue
+		}
+
+		flows := []struct {
+			name     string
+			flowDiff *diff.OAuthFlowDiff
+		}{
+			{"implicit", schemeDiff.OAuthFlowsDiff.ImplicitDiff},
+			{"password", schemeDiff.OAuthFlowsDiff.PasswordDiff},
+			{"clientCredentials", schemeDiff.OAuthFlowsDiff.ClientCredentialsDiff},
+			{"authorizationCode", schemeDiff.OAuthFlowsDiff.AuthorizationCodeDiff},
+		}
+		for _, flow := range flows {
+			if flow.flowDiff.Empty() {
+				continue
+			}
+
+			if flow.flowDiff.Added {
+				result = append(result, newError(apiSecurityComponentOAuthFlowAddedCheckId, INFO, scheme, ColorizedValue(flow.name), ColorizedValue(scheme)))
+				continue
+			}
+
+			if flow.flowDiff.Deleted {
+				result = append(result, newError(apiSecurityComponentOAuthFlowRemovedCheckId, ERR, scheme, ColorizedValue(flow.name), ColorizedValue(scheme)))
+				continue
+			}
+
+			urls := []struct {
+				name      string
+				valueDiff *diff.ValueDiff
+			}{
+				{"authorizationUrl", flow.flowDiff.AuthorizationURLDiff},
+				{"tokenUrl", flow.flowDiff.TokenURLDiff},
+				{"refreshUrl", flow.flowDiff.RefreshURLDiff},
+			}
+			for _, url := range urls {
+				if url.valueDiff == nil {
+					continue
+				}
+				result = append(result, newError(apiSecurityComponentOAuthUrlChangedCheckId, WARN, scheme,
+					url.name,
+					ColorizedValue(flow.name),
+					ColorizedValue(scheme),
+					ColorizedValue(url.valueDiff.From),
+					ColorizedValue(url.valueDiff.To)))
+			}
+
+			if flow.flowDiff.ScopesDiff == nil {
+				continue
+			}
+			for _, scope := range flow.flowDiff.ScopesDiff.Added {
+				result = append(result, newError(apiSecurityComponentOAuthScopeAddedCheckId, INFO, scheme, ColorizedValue(scope), ColorizedValue(flow.name), ColorizedValue(scheme)))
+			}
+			for _, scope := range flow.flowDiff.ScopesDiff.Deleted {
+				result = append(result, newError(apiSecurityComponentOAuthScopeRemovedCheckId, WARN, scheme, ColorizedValue(scope), ColorizedValue(flow.name), ColorizedValue(scheme)))
+			}
+		}
+	}
+
+	return result
+}
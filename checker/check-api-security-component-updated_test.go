@@ -0,0 +1,74 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: changing the location of an api key is breaking
+func TestAPISecurityComponent_InChanged(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityComponentUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s2.Spec.Components.SecuritySchemes["apiKey"].Value.In = "query"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-security-component-in-changed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "changed the api key location (in) of the security scheme 'apiKey' from 'header' to 'query'", errs[0].Text)
+	require.Equal(t, "components.securitySchemes.apiKey", errs[0].Source)
+}
+
+// BC: changing the name of an api key is breaking
+func TestAPISecurityComponent_NameChanged(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityComponentUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s2.Spec.Components.SecuritySchemes["apiKey"].Value.Name = "X-Key"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-security-component-name-changed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+}
+
+// CL: adding and removing security schemes
+func TestAPISecurityComponent_AddedAndRemoved(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityComponentUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		delete(s2.Spec.Components.SecuritySchemes, "bearerAuth")
+		s2.Spec.Components.SecuritySchemes["basicAuth"] = &openapi3.SecuritySchemeRef{Value: openapi3.NewSecurityScheme().WithType("http").WithScheme("basic")}
+	})
+	require.Len(t, errs, 2)
+	require.Equal(t, "api-security-component-removed", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "api-security-component-added", errs[1].Id)
+	require.Equal(t, checker.INFO, errs[1].Level)
+}
+
+// BC: removing an oauth flow is breaking
+func TestAPISecurityComponent_OAuthFlowRemoved(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityComponentUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		flows := s2.Spec.Components.SecuritySchemes["OAuth"].Value.Flows
+		flows.ClientCredentials = flows.AuthorizationCode
+		flows.AuthorizationCode = nil
+	})
+	require.Len(t, errs, 2)
+	require.Equal(t, "api-security-component-oauth-flow-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "removed the oauth flow 'authorizationCode' from the security scheme 'OAuth'", errs[0].Text)
+	require.Equal(t, "api-security-component-oauth-flow-added", errs[1].Id)
+	require.Equal(t, checker.INFO, errs[1].Level)
+}
+
+// CL: changing oauth scopes and urls
+func TestAPISecurityComponent_OAuthFlowModified(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityComponentUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		flow := s2.Spec.Components.SecuritySchemes["OAuth"].Value.Flows.AuthorizationCode
+		flow.TokenURL = "https://example.com/v2/token"
+		flow.Scopes = map[string]string{"read": "read access", "admin": "admin access"}
+	})
+	require.Len(t, errs, 3)
+	require.Equal(t, "api-security-component-oauth-scope-removed", errs[0].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", errs[1].Id)
+	require.Equal(t, "changed the tokenUrl of the oauth flow 'authorizationCode' of the security scheme 'OAuth' from 'https://example.com/token' to 'https://example.com/v2/token'", errs[1].Text)
+	require.Equal(t, "api-security-component-oauth-scope-added", errs[2].Id)
+}
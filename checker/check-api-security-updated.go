@@ -0,0 +1,212 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	apiSecurityAddedCheckId                = "api-security-added"
+	apiSecurityBecameRequiredCheckId       = "api-security-became-required"
+	apiSecurityBecameOptionalCheckId       = "api-security-became-optional"
+	apiSecurityRemovedCheckId              = "api-security-removed"
+	apiSecurityScopeAddedCheckId           = "api-security-scope-added"
+	apiSecurityScopeRemovedCheckId         = "api-security-scope-removed"
+	apiGlobalSecurityAddedCheckId          = "api-global-security-added"
+	apiGlobalSecurityBecameRequiredCheckId = "api-global-security-became-required"
+	apiGlobalSecurityBecameOptionalCheckId = "api-global-security-became-optional"
+	apiGlobalSecurityRemovedCheckId        = "api-global-security-removed"
+	apiGlobalSecurityScopeAddedCheckId     = "api-global-security-scope-added"
+	apiGlobalSecurityScopeRemovedCheckId   = "api-global-security-scope-removed"
+)
+
+// securityCheckIds are the ids reported for changes in a list of security requirements
+type securityCheckIds struct {
+	added          string
+	becameRequired string
+	becameOptional string
+	removed        string
+	scopeAdded     string
+	scopeRemoved   string
+}
+
+var apiSecurityCheckIds = securityCheckIds{
+	added:          apiSecurityAddedCheckId,
+	becameRequired: apiSecurityBecameRequiredCheckId,
+	becameOptional: apiSecurityBecameOptionalCheckId,
+	removed:        apiSecurityRemovedCheckId,
+	scopeAdded:     apiSecurityScopeAddedCheckId,
+	scopeRemoved:   apiSecurityScopeRemovedCheckId,
+}
+
+var apiGlobalSecurityCheckIds = securityCheckIds{
+	added:          apiGlobalSecurityAddedCheckId,
+	becameRequired: apiGlobalSecurityBecameRequiredCheckId,
+	becameOptional: apiGlobalSecurityBecameOptionalCheckId,
+	removed:        apiGlobalSecurityRemovedCheckId,
+	scopeAdded:     apiGlobalSecurityScopeAddedCheckId,
+	scopeRemoved:   apiGlobalSecurityScopeRemovedCheckId,
+}
+
+type securityChange struct {
+	id    string
+	level Level
+	args  []interface{}
+}
+
+// APISecurityUpdatedCheck checks changes in the security requirements of endpoints
+func APISecurityUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.SecurityDiff.Empty() {
+				continue
+			}
+
+			op := pathItem.Revision.Operations()[operation]
+			source := (*operationsSources)[op]
+
+			for _, change := range getSecurityChanges(operationItem.SecurityDiff, apiSecurityCheckIds) {
+				result = append(result, BackwardCompatibilityError{
+					Id:          change.id,
+					Level:       config.getLogLevel(change.id, change.level),
+					Text:        fmt.Sprintf(config.i18n(change.id), change.args...),
+					Operation:   operation,
+					OperationId: op.OperationID,
+					Path:        path,
+					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationItem.Base),
+					RevisionSource: config.getRevisionSource(operationItem.Revision),
+				})
+			}
+		}
+	}
+	return result
+}
+
+// APIGlobalSecurityUpdatedCheck checks changes in the global security requirements which apply to endpoints without security requirements of their own
+func APIGlobalSecurityUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.SecurityDiff.Empty() {
+		return result
+	}
+
+	for _, change := range getSecurityChanges(diffReport.SecurityDiff, apiGlobalSecurityCheckIds) {
+		result = append(result, BackwardCompatibilityError{
+			Id:        change.id,
+			Level:     config.getLogLevel(change.id, change.level),
+			Text:      fmt.Sprintf(config.i18n(change.id), change.args...),
+			Operation: "N/A",
+			Path:      "",
+			Source:    "security",
+
+			BaseSource:     config.getBaseSource(load.JSONPointer("security")),
+			RevisionSource: config.getRevisionSource(load.JSONPointer("security")),
+		})
+	}
+	return result
+}
+
+func getSecurityChanges(securityDiff *diff.SecurityRequirementsDiff, ids securityCheckIds) []securityChange {
+	result := []securityChange{}
+
+	baseRequired := isSecurityRequired(securityDiff.Base)
+	revisionRequired := isSecurityRequired(securityDiff.Revision)
+
+	switch {
+	case !baseRequired && revisionRequired:
+		// clients which didn't authenticate can no longer call the endpoint
+		result = append(result, securityChange{
+			id:    ids.becameRequired,
+			level: ERR,
+			args:  []interface{}{colorizedSecurityRequirements(securityDiff.Revision)},
+		})
+	case baseRequired && !revisionRequired:
+		result = append(result, securityChange{
+			id:    ids.becameOptional,
+			level: INFO,
+		})
+	default:
+		for _, requirement := range securityDiff.Added {
+			if requirement == "" {
+				continue
+			}
+			result = append(result, securityChange{
+				id:    ids.added,
+				level: INFO,
+				args:  []interface{}{ColorizedValue(requirement)},
+			})
+		}
+		for _, requirement := range securityDiff.Deleted {
+			if requirement == "" {
+				continue
+			}
+			// clients which used the deleted requirement can no longer call the endpoint
+			result = append(result, securityChange{
+				id:    ids.removed,
+				level: ERR,
+				args:  []interface{}{ColorizedValue(requirement)},
+			})
+		}
+	}
+
+	for _, scopesDiff := range securityDiff.Modified {
+		for scheme, scopeDiff := range scopesDiff {
+			if scopeDiff == nil {
+				continue
+			}
+			for _, scope := range scopeDiff.Added {
+				result = append(result, securityChange{
+					id:    ids.scopeAdded,
+					level: ERR,
+					args:  []interface{}{ColorizedValue(scope), ColorizedValue(scheme)},
+				})
+			}
+			for _, scope := range scopeDiff.Deleted {
+				result = append(result, securityChange{
+					id:    ids.scopeRemoved,
+					level: INFO,
+					args:  []interface{}{ColorizedValue(scope), ColorizedValue(scheme)},
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// isSecurityRequired indicates whether clients must satisfy at least one of the security requirements
+// an empty security requirement ({}) makes security optional
+func isSecurityRequired(securityRequirements *openapi3.SecurityRequirements) bool {
+	if securityRequirements == nil || len(*securityRequirements) == 0 {
+		return false
+	}
+
+	for _, securityRequirement := range *securityRequirements {
+		if len(securityRequirement) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func colorizedSecurityRequirements(securityRequirements *openapi3.SecurityRequirements) string {
+	result := []string{}
+	for _, securityRequirement := range *securityRequirements {
+		result = append(result, ColorizedValue(diff.GetSecurityRequirementID(securityRequirement)))
+	}
+	return strings.Join(result, " OR ")
+}
@@ -0,0 +1,105 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const securitySpec = "../data/security/base.yaml"
+
+// BC: adding a security requirement to an endpoint without security is breaking
+func TestAPISecurity_BecameRequired(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s2.Spec.Paths["/pets"].Get.Security = openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("bearerAuth"))
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-security-became-required", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "GET", errs[0].Operation)
+	require.Equal(t, "/pets", errs[0].Path)
+	require.Equal(t, "security became required, the endpoint now requires 'bearerAuth'", errs[0].Text)
+}
+
+// BC: adding an alternative security requirement is not breaking
+func TestAPISecurity_AlternativeAdded(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s2.Spec.Paths["/pets"].Post.Security.With(openapi3.NewSecurityRequirement().Authenticate("bearerAuth"))
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-security-added", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+	require.Equal(t, "added the new alternative security requirement 'bearerAuth'", errs[0].Text)
+}
+
+// BC: removing one of the alternative security requirements is breaking
+func TestAPISecurity_Removed(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s1.Spec.Paths["/pets"].Post.Security.With(openapi3.NewSecurityRequirement().Authenticate("bearerAuth"))
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-security-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "removed the security requirement 'bearerAuth'", errs[0].Text)
+}
+
+// BC: allowing anonymous access is not breaking
+func TestAPISecurity_BecameOptional(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s2.Spec.Paths["/pets"].Post.Security.With(openapi3.NewSecurityRequirement())
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-security-became-optional", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+}
+
+// BC: adding a required scope is breaking, removing one isn't
+func TestAPISecurity_Scopes(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s1.Spec.Paths["/pets"].Post.Security = openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("OAuth", "read"))
+		s2.Spec.Paths["/pets"].Post.Security = openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("OAuth", "write"))
+	})
+	require.Len(t, errs, 2)
+	require.Equal(t, "api-security-scope-added", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "added the required scope 'write' to the security scheme 'OAuth'", errs[0].Text)
+	require.Equal(t, "api-security-scope-removed", errs[1].Id)
+	require.Equal(t, checker.INFO, errs[1].Level)
+}
+
+// BC: declaring the inherited global security explicitly on an endpoint is not a change
+func TestAPISecurity_InheritedGlobalSecurity(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APISecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s1.Spec.Security = *openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("apiKey"))
+		s2.Spec.Security = *openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("apiKey"))
+		s2.Spec.Paths["/pets"].Get.Security = openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("apiKey"))
+	})
+	require.Empty(t, errs)
+}
+
+// BC: adding global security to a spec without security is breaking
+func TestAPIGlobalSecurity_BecameRequired(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APIGlobalSecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s2.Spec.Security = *openapi3.NewSecurityRequirements().
+			With(openapi3.NewSecurityRequirement().Authenticate("apiKey")).
+			With(openapi3.NewSecurityRequirement().Authenticate("bearerAuth"))
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-global-security-became-required", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "global security became required, endpoints without security requirements of their own now require 'apiKey' OR 'bearerAuth'", errs[0].Text)
+}
+
+// BC: removing a global security scope is not breaking
+func TestAPIGlobalSecurity_ScopeRemoved(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.APIGlobalSecurityUpdatedCheck), securitySpec, securitySpec, func(s1, s2 *load.SpecInfo) {
+		s1.Spec.Security = *openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("OAuth", "read", "write"))
+		s2.Spec.Security = *openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("OAuth", "read"))
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "api-global-security-scope-removed", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+}
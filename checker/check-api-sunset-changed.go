@@ -35,6 +35,9 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 			}
 
@@ -54,6 +57,9 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -68,6 +74,9 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      (*operationsSources)[opBase],
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -84,6 +93,9 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 				continue
 			}
@@ -99,6 +111,9 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationDiff.Base),
+					RevisionSource: config.getRevisionSource(op),
 				})
 			}
 		}
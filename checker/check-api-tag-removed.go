@@ -38,6 +38,9 @@ func APITagRemovedCheck(diffReport *diff.Diff, operationsSources *diff.Operation
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(op),
+					RevisionSource: config.getRevisionSource(operationItem.Revision),
 				})
 
 			}
@@ -0,0 +1,232 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	callbackAddedId            = "callback-added"
+	callbackRemovedId          = "callback-removed"
+	callbackPathAddedId        = "callback-path-added"
+	callbackPathRemovedId      = "callback-path-removed"
+	callbackOperationAddedId   = "callback-operation-added"
+	callbackOperationRemovedId = "callback-operation-removed"
+	callbackRequestChangedId   = "callback-request-changed"
+	callbackResponseChangedId  = "callback-response-changed"
+	callbackRequestStatusId    = "callback-request-status"
+	callbackIdPrefix           = "callback-"
+)
+
+// CallbacksCheck runs the request and response checks on the callbacks of modified operations
+// in a callback the API provider is the client, so the direction is inverted:
+// - removing a callback, a callback expression or a callback operation breaks the subscribers which rely on it
+// - the callback request body is sent by the provider to the subscribers, so it is checked like a response, and new required properties break the subscribers' servers
+// - the callback responses are sent by the subscribers to the provider, so they are checked like request bodies
+// the errors are reported on the parent operation with the callback expression, and their ids are prefixed by callback-
+func CallbacksCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.CallbacksDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			// the errors are located at the most specific of the given base and revision elements, or at the operation
+			newError := func(id string, defaultLevel Level, baseElement interface{}, revisionElement interface{}, args ...interface{}) BackwardCompatibilityError {
+				return BackwardCompatibilityError{
+					Id:          id,
+					Level:       config.getLogLevel(id, defaultLevel),
+					Text:        fmt.Sprintf(config.i18n(id), args...),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					BaseSource:     config.getBaseSource(baseElement, operationItem.Base),
+					RevisionSource: config.getRevisionSource(revisionElement, operationItem.Revision),
+				}
+			}
+
+			for _, callbackName := range operationItem.CallbacksDiff.Added {
+				result = append(result, newError(callbackAddedId, INFO, nil, getCallback(operationItem.Revision, callbackName), ColorizedValue(callbackName)))
+			}
+
+			for _, callbackName := range operationItem.CallbacksDiff.Deleted {
+				result = append(result, newError(callbackRemovedId, ERR, getCallback(operationItem.Base, callbackName), nil, ColorizedValue(callbackName)))
+			}
+
+			for callbackName, callbackDiff := range operationItem.CallbacksDiff.Modified {
+				if callbackDiff == nil {
+					continue
+				}
+				baseCallback, revisionCallback := getCallback(operationItem.Base, callbackName), getCallback(operationItem.Revision, callbackName)
+
+				for _, callbackPath := range callbackDiff.Added {
+					result = append(result, newError(callbackPathAddedId, INFO, baseCallback, callbackDiff.Revision[callbackPath], ColorizedValue(callbackPath), ColorizedValue(callbackName)))
+				}
+
+				for _, callbackPath := range callbackDiff.Deleted {
+					result = append(result, newError(callbackPathRemovedId, ERR, callbackDiff.Base[callbackPath], revisionCallback, ColorizedValue(callbackPath), ColorizedValue(callbackName)))
+				}
+
+				for callbackPath, callbackPathItem := range callbackDiff.Modified {
+					if callbackPathItem.OperationsDiff == nil {
+						continue
+					}
+
+					for _, callbackOperation := range callbackPathItem.OperationsDiff.Added {
+						result = append(result, newError(callbackOperationAddedId, INFO, callbackPathItem.Base, callbackPathItem.Revision.GetOperation(callbackOperation), ColorizedValue(callbackOperation+" "+callbackPath), ColorizedValue(callbackName)))
+					}
+
+					for _, callbackOperation := range callbackPathItem.OperationsDiff.Deleted {
+						result = append(result, newError(callbackOperationRemovedId, ERR, callbackPathItem.Base.GetOperation(callbackOperation), callbackPathItem.Revision, ColorizedValue(callbackOperation+" "+callbackPath), ColorizedValue(callbackName)))
+					}
+
+					for callbackOperation, callbackOperationItem := range callbackPathItem.OperationsDiff.Modified {
+						callbackEndpoint := ColorizedValue(callbackOperation + " " + callbackPath)
+						for _, callbackErr := range checkCallbackOperation(config, ColorizedValue(callbackName), callbackEndpoint, callbackOperation, callbackOperationItem) {
+							callbackErr.Operation = operation
+							callbackErr.OperationId = operationItem.Revision.OperationID
+							callbackErr.Path = path
+							callbackErr.Source = source
+							result = append(result, callbackErr)
+						}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// getCallback returns the callback of an operation by its name, or nil if there is none
+func getCallback(operation *openapi3.Operation, name string) *openapi3.Callback {
+	if operation == nil || operation.Callbacks[name] == nil {
+		return nil
+	}
+	return operation.Callbacks[name].Value
+}
+
+// checkCallbackOperation runs the checks on the inverted diffs of a callback operation
+func checkCallbackOperation(config BackwardCompatibilityCheckConfig, callbackName string, callbackEndpoint string, callbackOperation string, callbackOperationItem *diff.MethodDiff) []BackwardCompatibilityError {
+	return checkInvertedOperation(config, callbackIdPrefix, callbackOperation, callbackOperationItem, config.i18n(callbackRequestStatusId),
+		func(text string) string {
+			return fmt.Sprintf(config.i18n(callbackRequestChangedId), callbackName, callbackEndpoint, text)
+		},
+		func(responseStatus string, text string) string {
+			return fmt.Sprintf(config.i18n(callbackResponseChangedId), ColorizedValue(responseStatus), callbackName, callbackEndpoint, text)
+		})
+}
+
+// checkInvertedOperation runs the checks on the inverted diffs of an operation which is initiated by the API provider, like a callback or a webhook
+// the request body is checked like a response with the given status label, and for new required properties, which break the subscribers' servers
+// the ids of the errors are prefixed by idPrefix, except new required properties in the request body which are reported as request-required-property-added, and their levels are set by the overrides of the prefixed ids, so that they can be configured separately from the errors of regular operations
+// requestText and responseText add the context of the operation to the errors found in its request body and in its responses
+// errors which aren't located at an element of the operation, like a property schema, are located at the operation, since the checks run on diffs of synthetic operations
+func checkInvertedOperation(config BackwardCompatibilityCheckConfig, idPrefix string, operation string, methodDiff *diff.MethodDiff, requestStatus string, requestText func(text string) string, responseText func(responseStatus string, text string) string) []BackwardCompatibilityError {
+	result := []BackwardCompatibilityError{}
+
+	invertedConfig := config
+	invertedConfig.LogLevelOverrides = nil
+
+	appendErrors := func(errs []BackwardCompatibilityError, id string, text func(text string) string) {
+		for _, err := range errs {
+			err.Id = idPrefix + err.Id
+			if id != "" {
+				err.Id = id
+			}
+			err.Level = config.getLogLevel(err.Id, err.Level)
+			err.Text = text(err.Text)
+			if err.BaseSource == nil {
+				err.BaseSource = config.getBaseSource(methodDiff.Base)
+			}
+			if err.RevisionSource == nil {
+				err.RevisionSource = config.getRevisionSource(methodDiff.Revision)
+			}
+			result = append(result, err)
+		}
+	}
+
+	if requestDiff := invertCallbackRequest(requestStatus, methodDiff); requestDiff != nil {
+		appendErrors(runOperationChecks(invertedConfig, operationChecks(), "", operation, requestDiff), "", requestText)
+	}
+
+	if methodDiff.RequestBodyDiff != nil {
+		appendErrors(runOperationChecks(invertedConfig, []BackwardCompatibilityCheck{NewRequiredRequestPropertyCheck}, "", operation, &diff.MethodDiff{
+			RequestBodyDiff: methodDiff.RequestBodyDiff,
+			Base:            methodDiff.Base,
+			Revision:        methodDiff.Revision,
+		}), idPrefix+"request-required-property-added", requestText)
+	}
+
+	if methodDiff.ResponsesDiff == nil {
+		return result
+	}
+	for responseStatus, responseDiff := range methodDiff.ResponsesDiff.Modified {
+		responseMethodDiff := invertCallbackResponse(responseDiff)
+		if responseMethodDiff == nil {
+			continue
+		}
+		appendErrors(runOperationChecks(invertedConfig, operationChecks(), "", operation, responseMethodDiff), "", func(text string) string {
+			return responseText(responseStatus, text)
+		})
+	}
+
+	return result
+}
+
+// invertCallbackRequest returns a diff of an operation whose response with the given status is the request body of the callback
+func invertCallbackRequest(status string, callbackOperationItem *diff.MethodDiff) *diff.MethodDiff {
+	requestBodyDiff := callbackOperationItem.RequestBodyDiff
+	if requestBodyDiff == nil || requestBodyDiff.ContentDiff == nil ||
+		callbackOperationItem.Base.RequestBody == nil || callbackOperationItem.Base.RequestBody.Value == nil ||
+		callbackOperationItem.Revision.RequestBody == nil || callbackOperationItem.Revision.RequestBody.Value == nil {
+		return nil
+	}
+
+	base := &openapi3.Response{Content: callbackOperationItem.Base.RequestBody.Value.Content}
+	revision := &openapi3.Response{Content: callbackOperationItem.Revision.RequestBody.Value.Content}
+
+	return &diff.MethodDiff{
+		ResponsesDiff: &diff.ResponsesDiff{
+			Modified: diff.ModifiedResponses{
+				status: &diff.ResponseDiff{
+					ContentDiff: requestBodyDiff.ContentDiff,
+					Base:        base,
+					Revision:    revision,
+				},
+			},
+		},
+		Base:     &openapi3.Operation{Responses: openapi3.Responses{status: &openapi3.ResponseRef{Value: base}}},
+		Revision: &openapi3.Operation{Responses: openapi3.Responses{status: &openapi3.ResponseRef{Value: revision}}},
+	}
+}
+
+// invertCallbackResponse returns a diff of an operation whose request body is a response of the callback
+func invertCallbackResponse(responseDiff *diff.ResponseDiff) *diff.MethodDiff {
+	if responseDiff == nil || responseDiff.ContentDiff == nil || responseDiff.Base == nil || responseDiff.Revision == nil {
+		return nil
+	}
+
+	// subscribers always send the response body
+	base := &openapi3.RequestBody{Content: responseDiff.Base.Content, Required: true}
+	revision := &openapi3.RequestBody{Content: responseDiff.Revision.Content, Required: true}
+
+	return &diff.MethodDiff{
+		RequestBodyDiff: &diff.RequestBodyDiff{
+			ContentDiff: responseDiff.ContentDiff,
+		},
+		Base:     &openapi3.Operation{RequestBody: &openapi3.RequestBodyRef{Value: base}},
+		Revision: &openapi3.Operation{RequestBody: &openapi3.RequestBodyRef{Value: revision}},
+	}
+}
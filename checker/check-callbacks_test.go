@@ -0,0 +1,169 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const callbacksSpec = "../data/callbacks/spec_1.yaml"
+
+func callbackMessageSchema(s *load.SpecInfo) *openapi3.Schema {
+	return s.Spec.Components.RequestBodies["callbackMessage1"].Value.Content["application/json;charset=utf-8"].Schema.Value
+}
+
+func callbackResponse(s *load.SpecInfo) *openapi3.Response {
+	return (*s.Spec.Paths["/subscribe"].Post.Callbacks["inProgress"].Value)["{$request.body#/callback}"].Post.Responses["200"].Value
+}
+
+// BC: removing a required property from a callback request body is breaking
+func TestCallbacks_RequestRequiredPropertyRemoved(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		callbackMessageSchema(s1).Required = []string{"inProgressUrl"}
+		callbackMessageSchema(s2).Required = []string{"inProgressUrl"}
+		delete(callbackMessageSchema(s2).Properties, "inProgressUrl")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-response-required-property-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "POST", errs[0].Operation)
+	require.Equal(t, "/subscribe", errs[0].Path)
+	require.Equal(t, "../data/callbacks/spec_1.yaml", errs[0].Source)
+	require.Equal(t, "in the request of the callback 'inProgress' 'POST {$request.body#/callback}', which is sent to subscribers: removed the required property 'inProgressUrl' from the response with the 'callback request' status", errs[0].Text)
+}
+
+// BC: adding a required property to a callback request body is breaking
+func TestCallbacks_RequestRequiredPropertyAdded(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		callbackMessageSchema(s2).Properties["retryUrl"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+		callbackMessageSchema(s2).Required = []string{"retryUrl"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-request-required-property-added", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "/subscribe", errs[0].Path)
+	require.Equal(t, "in the request of the callback 'inProgress' 'POST {$request.body#/callback}', which is sent to subscribers: added the new required request property 'retryUrl'", errs[0].Text)
+}
+
+// BC: removing a callback is breaking
+func TestCallbacks_Removed(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		delete(s2.Spec.Paths["/subscribe"].Post.Callbacks, "Failed")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.BackwardCompatibilityError{
+		Id:        "callback-removed",
+		Text:      "removed the callback 'Failed', subscribers will no longer receive it",
+		Level:     checker.ERR,
+		Operation: "POST",
+		Path:      "/subscribe",
+		Source:    "../data/callbacks/spec_1.yaml",
+	}, errs[0])
+}
+
+// BC: adding a callback is not breaking
+func TestCallbacks_Added(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		delete(s1.Spec.Paths["/subscribe"].Post.Callbacks, "Failed")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-added", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+	require.Equal(t, "added the callback 'Failed'", errs[0].Text)
+}
+
+// BC: removing an expression from a callback is breaking
+func TestCallbacks_PathRemoved(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		callback := *s1.Spec.Paths["/subscribe"].Post.Callbacks["Failed"].Value
+		callback["{$request.body#/callback}/retry"] = callback["{$request.body#/callback}"]
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-path-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "removed the expression '{$request.body#/callback}/retry' from the callback 'Failed', subscribers will no longer receive it", errs[0].Text)
+}
+
+// BC: removing an operation from a callback is breaking
+func TestCallbacks_OperationRemoved(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		callbackPath := (*s1.Spec.Paths["/subscribe"].Post.Callbacks["Failed"].Value)["{$request.body#/callback}"]
+		callbackPath.Put = callbackPath.Post
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-operation-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "POST", errs[0].Operation)
+	require.Equal(t, "removed the operation 'PUT {$request.body#/callback}' from the callback 'Failed', subscribers will no longer receive it", errs[0].Text)
+}
+
+// BC: adding an operation to a callback is not breaking
+func TestCallbacks_OperationAdded(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		callbackPath := (*s2.Spec.Paths["/subscribe"].Post.Callbacks["Failed"].Value)["{$request.body#/callback}"]
+		callbackPath.Put = callbackPath.Post
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-operation-added", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+}
+
+func TestCallbacks_LogLevelOverrides(t *testing.T) {
+	s1, err := open("../data/callbacks/spec_1.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/callbacks/spec_1.yaml")
+	require.NoError(t, err)
+	callbackMessageSchema(s1).Required = []string{"inProgressUrl"}
+	callbackMessageSchema(s2).Required = []string{"inProgressUrl"}
+	delete(callbackMessageSchema(s2).Properties, "inProgressUrl")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+
+	config := checker.GetDefaultChecks()
+	config.LogLevelOverrides = map[string]checker.Level{"response-required-property-removed": checker.INFO}
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ERR, errs[0].Level)
+
+	config.LogLevelOverrides = map[string]checker.Level{"callback-response-required-property-removed": checker.WARN}
+	errs = checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.WARN, errs[0].Level)
+}
+
+// BC: adding a required property to a callback response is breaking
+func TestCallbacks_ResponseRequiredPropertyAdded(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		callbackResponse(s1).Content = openapi3.NewContentWithJSONSchema(openapi3.NewObjectSchema().
+			WithProperty("status", openapi3.NewStringSchema()))
+		schema := openapi3.NewObjectSchema().
+			WithProperty("status", openapi3.NewStringSchema()).
+			WithProperty("reason", openapi3.NewStringSchema())
+		schema.Required = []string{"reason"}
+		callbackResponse(s2).Content = openapi3.NewContentWithJSONSchema(schema)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "callback-new-required-request-property", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "/subscribe", errs[0].Path)
+	require.Equal(t, "in the response with the status '200' of the callback 'inProgress' 'POST {$request.body#/callback}', which is sent by subscribers and checked like a request: added the new required request property 'reason'", errs[0].Text)
+}
+
+// BC: removing a required property from a callback response is not breaking
+func TestCallbacks_ResponseRequiredPropertyRemoved(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), callbacksSpec, callbacksSpec, func(s1, s2 *load.SpecInfo) {
+		schema := openapi3.NewObjectSchema().
+			WithProperty("status", openapi3.NewStringSchema())
+		schema.Required = []string{"status"}
+		callbackResponse(s1).Content = openapi3.NewContentWithJSONSchema(schema)
+		callbackResponse(s2).Content = openapi3.NewContentWithJSONSchema(openapi3.NewObjectSchema())
+	})
+	for _, err := range errs {
+		require.NotEqual(t, checker.ERR, err.Level, err.Text)
+	}
+}
@@ -24,6 +24,8 @@ func APIComponentsSchemaRemovedCheck(diffReport *diff.Diff, operationsSources *d
 			Operation: "N/A",
 			Path:      "",
 			Source:    "components.schemas." + deletedSchema, // TODO: get the file name
+
+			BaseSource: config.getBaseSource(schemaOf(diffReport.ComponentsDiff.SchemasDiff.Base[deletedSchema])),
 		})
 	}
 	return result
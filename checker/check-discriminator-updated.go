@@ -0,0 +1,89 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+/*
+DiscriminatorUpdatedCheck reports the changes in the discriminators of request and response bodies and their properties
+- changing the property name of a discriminator, or the schema which a mapping value refers to, is breaking in both directions
+- removing a mapping value breaks clients which send it in requests, and clients which handle it in responses
+- adding a mapping value to a response may break clients which don't expect it
+*/
+func DiscriminatorUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			walkBodySchemaDiffs(operationItem, func(location bodySchemaLocation, schemaDiff *diff.SchemaDiff) {
+				discriminatorDiff := schemaDiff.DiscriminatorDiff
+				if discriminatorDiff == nil {
+					return
+				}
+
+				appendResultItem := func(change string, defaultLevel Level, args ...interface{}) {
+					id := location.id(change)
+					result = append(result, BackwardCompatibilityError{
+						Id:          id,
+						Level:       config.getLogLevel(id, defaultLevel),
+						Text:        fmt.Sprintf(config.i18n(id), location.args(args...)...),
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      source,
+
+						PropertyPath:   location.propertyName,
+						BaseSource:     config.getBaseSource(baseSchema(schemaDiff), operationItem.Base),
+						RevisionSource: config.getRevisionSource(revisionSchema(schemaDiff), operationItem.Revision),
+					})
+				}
+
+				if discriminatorDiff.Added {
+					appendResultItem("discriminator-added", location.level(WARN, INFO))
+					return
+				}
+
+				if discriminatorDiff.Deleted {
+					appendResultItem("discriminator-removed", location.level(INFO, WARN))
+					return
+				}
+
+				if discriminatorDiff.PropertyNameDiff != nil {
+					appendResultItem("discriminator-property-name-changed", ERR,
+						ColorizedValue(discriminatorDiff.PropertyNameDiff.From),
+						ColorizedValue(discriminatorDiff.PropertyNameDiff.To))
+				}
+
+				mappingDiff := discriminatorDiff.MappingDiff
+				if mappingDiff == nil {
+					return
+				}
+
+				for _, key := range mappingDiff.Added {
+					appendResultItem("discriminator-mapping-added", location.level(INFO, WARN), ColorizedValue(key))
+				}
+
+				for _, key := range mappingDiff.Deleted {
+					appendResultItem("discriminator-mapping-removed", ERR, ColorizedValue(key))
+				}
+
+				for key, valueDiff := range mappingDiff.Modified {
+					appendResultItem("discriminator-mapping-changed", ERR,
+						ColorizedValue(key),
+						ColorizedValue(valueDiff.From),
+						ColorizedValue(valueDiff.To))
+				}
+			})
+		}
+	}
+	return result
+}
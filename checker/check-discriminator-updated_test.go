@@ -0,0 +1,79 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// BC: removing a discriminator mapping value from a request is breaking
+// BC: changing the discriminator property name or the schema of a mapping value is breaking
+// CL: adding a discriminator mapping value to a request
+func TestDiscriminatorUpdated(t *testing.T) {
+	newError := newOperationError("POST", "createPet", "/pets", "../data/discriminator/revision.yaml")
+	errs := getChanges(t, singleCheckConfig(checker.DiscriminatorUpdatedCheck), "../data/discriminator/base.yaml", "../data/discriminator/revision.yaml", nil)
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		newError("request-body-discriminator-mapping-removed", checker.ERR, "removed the discriminator mapping value 'lizard' from the request body"),
+		newError("request-body-discriminator-mapping-added", checker.INFO, "added the discriminator mapping value 'bird' to the request body"),
+		withProperty(newError("response-property-discriminator-property-name-changed", checker.ERR, "changed the discriminator property name from 'petType' to 'kind' in the response property 'pet' for the response status '200'"), "pet"),
+		withProperty(newError("response-property-discriminator-mapping-changed", checker.ERR, "changed the discriminator mapping value 'dog' from '#/components/schemas/Dog' to '#/components/schemas/Bird' in the response property 'pet' for the response status '200'"), "pet"),
+	}, errs)
+}
+
+// BC: adding a discriminator mapping value to a response is a potential breaking change
+// BC: removing a discriminator mapping value from a response is breaking
+func TestDiscriminatorMappingAdded_Response(t *testing.T) {
+	s1, err := open("../data/discriminator/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/discriminator/base.yaml")
+	require.NoError(t, err)
+
+	s2.Spec.Paths["/pets"].Post.Responses["200"].Value.Content["application/json"].Schema.Value.Properties["pet"].Value.Discriminator.Mapping = map[string]string{
+		"cat":  "#/components/schemas/Cat",
+		"dog":  "#/components/schemas/Dog",
+		"bird": "#/components/schemas/Bird",
+	}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.DiscriminatorUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-property-discriminator-mapping-added", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "added the discriminator mapping value 'bird' to the response property 'pet' for the response status '200'", errs[0].Text)
+
+	d, osm, err = diff.GetWithOperationsSourcesMap(getConfig(), s2, s1)
+	require.NoError(t, err)
+	errs = checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.DiscriminatorUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-property-discriminator-mapping-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+}
+
+// BC: removing a discriminator from a response is a potential breaking change
+// CL: adding a discriminator to a response
+func TestDiscriminatorRemoved_Response(t *testing.T) {
+	s1, err := open("../data/discriminator/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/discriminator/base.yaml")
+	require.NoError(t, err)
+
+	s2.Spec.Paths["/pets"].Post.Responses["200"].Value.Content["application/json"].Schema.Value.Properties["pet"].Value.Discriminator = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.DiscriminatorUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-property-discriminator-removed", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "removed the discriminator from the response property 'pet' for the response status '200'", errs[0].Text)
+
+	d, osm, err = diff.GetWithOperationsSourcesMap(getConfig(), s2, s1)
+	require.NoError(t, err)
+	errs = checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.DiscriminatorUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-property-discriminator-added", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+}
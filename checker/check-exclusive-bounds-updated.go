@@ -0,0 +1,35 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+// ExclusiveBoundsUpdatedCheck checks a minimum or maximum which became exclusive in requests, since clients may send the bound itself, or which became inclusive in responses, since clients may not expect it
+func ExclusiveBoundsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	return checkConstraintChanges(diffReport, operationsSources, config, func(request bool, schemaDiff *diff.SchemaDiff) []constraintChange {
+		if schemaDiff.Revision == nil || schemaDiff.Revision.Value == nil {
+			return nil
+		}
+		revision := schemaDiff.Revision.Value
+
+		result := []constraintChange{}
+		if revision.Min != nil {
+			result = append(result, getExclusiveBoundChanges("min", request, schemaDiff.ExclusiveMinDiff, *revision.Min)...)
+		}
+		if revision.Max != nil {
+			result = append(result, getExclusiveBoundChanges("max", request, schemaDiff.ExclusiveMaxDiff, *revision.Max)...)
+		}
+		return result
+	})
+}
+
+// getExclusiveBoundChanges returns the change of an exclusiveMinimum or exclusiveMaximum, the bound itself is compared by the min and max checks
+func getExclusiveBoundChanges(bound string, request bool, exclusiveDiff *diff.ValueDiff, value float64) []constraintChange {
+	if request && exclusiveDiff.CompareWithDefault(false, true, false) {
+		return newConstraintChange(bound+"-became-exclusive", ERR, value)
+	}
+	if !request && exclusiveDiff.CompareWithDefault(true, false, false) {
+		return newConstraintChange(bound+"-became-inclusive", ERR, value)
+	}
+	return nil
+}
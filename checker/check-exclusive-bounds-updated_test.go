@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: making the minimum of a request property exclusive is breaking
+func TestExclusiveMinSet(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.ExclusiveBoundsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "quantity").ExclusiveMin = true
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("request-property-min-became-exclusive", checker.ERR, "the minimum '1.00' became exclusive in the request property 'quantity'"), "quantity"),
+	}, errs)
+}
+
+// BC: making the maximum of a response property inclusive is breaking
+func TestExclusiveMaxUnset(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.ExclusiveBoundsUpdatedCheck), constraintsSpec, constraintsSpec, func(s, _ *load.SpecInfo) {
+		getItemSchema(s, "quantity").ExclusiveMax = true
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("response-property-max-became-inclusive", checker.ERR, "the maximum '100.00' became inclusive in the response property 'quantity' for the response status '200'"), "quantity"),
+	}, errs)
+}
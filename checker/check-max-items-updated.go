@@ -0,0 +1,12 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+// MaxItemsUpdatedCheck checks changes to the maxItems of request parameters, bodies, properties and response headers, see getMaxChanges
+func MaxItemsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	return checkConstraintChanges(diffReport, operationsSources, config, func(request bool, schemaDiff *diff.SchemaDiff) []constraintChange {
+		return getMaxChanges("max-items", request, schemaDiff.MaxItemsDiff)
+	})
+}
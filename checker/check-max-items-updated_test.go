@@ -0,0 +1,71 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const constraintsSpec = "../data/constraints/base.yaml"
+
+func getItemSchema(s *load.SpecInfo, property string) *openapi3.Schema {
+	return s.Spec.Components.Schemas["Item"].Value.Properties[property].Value
+}
+
+// BC: decreasing maxItems of a request property is breaking
+func TestMaxItemsDecreased(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MaxItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "tags").MaxItems = openapi3.Uint64Ptr(3)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("request-property-max-items-decreased", checker.ERR, "the maxItems was decreased from '5' to '3' in the request property 'tags'"), "tags"),
+	}, errs)
+}
+
+// BC: increasing maxItems of a response property is breaking
+func TestMaxItemsIncreased(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MaxItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "tags").MaxItems = openapi3.Uint64Ptr(8)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("response-property-max-items-increased", checker.ERR, "the maxItems was increased from '5' to '8' in the response property 'tags' for the response status '200'"), "tags"),
+	}, errs)
+}
+
+// BC: decreasing maxItems of a request parameter is breaking
+func TestMaxItemsParameterDecreased(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MaxItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		s.Spec.Paths["/items"].Post.Parameters.GetByInAndName("query", "ids").Schema.Value.MaxItems = openapi3.Uint64Ptr(5)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withParameter(newError("request-parameter-max-items-decreased", checker.ERR, "for the 'query' request parameter 'ids', the maxItems was decreased from '10' to '5'"), "ids"),
+	}, errs)
+}
+
+// BC: unsetting maxItems of a response header is breaking
+func TestMaxItemsHeaderUnset(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MaxItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		s.Spec.Paths["/items"].Post.Responses["200"].Value.Headers["X-Tags"].Value.Schema.Value.MaxItems = nil
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withHeader(newError("response-header-max-items-unset", checker.ERR, "the response header 'X-Tags' maxItems was unset from '3' for the response status '200'"), "X-Tags"),
+	}, errs)
+}
+
+// BC: setting maxItems of a request property is a potential breaking change
+func TestMaxItemsSet(t *testing.T) {
+	expected := newOperationError("POST", "createItem", "/items", constraintsSpec)("request-property-max-items-set", checker.WARN, "the maxItems was set to '5' in the request property 'tags'")
+	expected.Comment = "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification."
+	expected.PropertyPath = "tags"
+	errs := getChanges(t, singleCheckConfig(checker.MaxItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(s, _ *load.SpecInfo) {
+		getItemSchema(s, "tags").MaxItems = nil
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{expected}, errs)
+}
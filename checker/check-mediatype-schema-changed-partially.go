@@ -0,0 +1,162 @@
+package checker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	requestBodySchemaChangedPartiallyId = "request-body-schema-changed-partially"
+	responseSchemaChangedPartiallyId    = "response-schema-changed-partially"
+)
+
+// MediaTypeSchemaChangedPartiallyCheck reports request and response bodies whose schema changed in some media types but not in others, which may indicate that one of the representations was forgotten
+// only changes which affect validation are considered, so that changing a description or an example in one of the media types isn't reported
+func MediaTypeSchemaChangedPartiallyCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			newError := func(id string, revisionElement interface{}, args ...interface{}) BackwardCompatibilityError {
+				return BackwardCompatibilityError{
+					Id:          id,
+					Level:       config.getLogLevel(id, WARN),
+					Text:        fmt.Sprintf(config.i18n(id), args...),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationItem.Base),
+					RevisionSource: config.getRevisionSource(revisionElement, operationItem.Revision),
+				}
+			}
+
+			if operationItem.RequestBodyDiff != nil && operationItem.Revision.RequestBody != nil && operationItem.Revision.RequestBody.Value != nil {
+				if changed, unchanged := getPartialSchemaChanges(operationItem.RequestBodyDiff.ContentDiff, operationItem.Revision.RequestBody.Value.Content); len(changed) > 0 {
+					result = append(result, newError(requestBodySchemaChangedPartiallyId, operationItem.Revision.RequestBody.Value, ColorizedValue(changed), ColorizedValue(unchanged)))
+				}
+			}
+
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				responseRef := operationItem.Revision.Responses[responseStatus]
+				if responseRef == nil || responseRef.Value == nil {
+					continue
+				}
+				if changed, unchanged := getPartialSchemaChanges(responseDiff.ContentDiff, responseRef.Value.Content); len(changed) > 0 {
+					result = append(result, newError(responseSchemaChangedPartiallyId, responseRef.Value, ColorizedValue(responseStatus), ColorizedValue(changed), ColorizedValue(unchanged)))
+				}
+			}
+		}
+	}
+	return result
+}
+
+// getPartialSchemaChanges returns the media types whose schema changed and the media types whose schema didn't change, if there are both
+func getPartialSchemaChanges(contentDiff *diff.ContentDiff, content openapi3.Content) (string, string) {
+	if contentDiff == nil || len(contentDiff.MediaTypeModified) == 0 {
+		return "", ""
+	}
+
+	added := contentDiff.MediaTypeAdded.ToStringSet()
+	changed := []string{}
+	unchanged := []string{}
+	for mediaType := range content {
+		if added.Contains(mediaType) {
+			continue
+		}
+		if mediaTypeDiff, ok := contentDiff.MediaTypeModified[mediaType]; ok && affectsValidation(mediaTypeDiff.SchemaDiff) {
+			changed = append(changed, mediaType)
+			continue
+		}
+		unchanged = append(unchanged, mediaType)
+	}
+
+	if len(changed) == 0 || len(unchanged) == 0 {
+		return "", ""
+	}
+
+	sort.Strings(changed)
+	sort.Strings(unchanged)
+	return strings.Join(changed, ", "), strings.Join(unchanged, ", ")
+}
+
+// affectsValidation indicates whether a schema diff changes the values which the schema accepts, that is, it isn't limited to annotations like description, title and example
+func affectsValidation(schemaDiff *diff.SchemaDiff) bool {
+	if schemaDiff.Empty() {
+		return false
+	}
+
+	for _, subschemaDiff := range []*diff.SchemaDiff{
+		schemaDiff.NotDiff,
+		schemaDiff.ItemsDiff,
+		schemaDiff.AdditionalPropertiesDiff,
+		schemaDiff.IfDiff,
+		schemaDiff.ThenDiff,
+		schemaDiff.ElseDiff,
+		schemaDiff.UnevaluatedPropertiesDiff,
+	} {
+		if affectsValidation(subschemaDiff) {
+			return true
+		}
+	}
+
+	for _, listDiff := range []*diff.SchemaListDiff{schemaDiff.OneOfDiff, schemaDiff.AnyOfDiff, schemaDiff.AllOfDiff, schemaDiff.PrefixItemsDiff} {
+		if listDiff != nil && (listDiff.Added > 0 || listDiff.Deleted > 0 || modifiedSchemasAffectValidation(listDiff.Modified)) {
+			return true
+		}
+	}
+
+	for _, schemasDiff := range []*diff.SchemasDiff{schemaDiff.PropertiesDiff, schemaDiff.DefsDiff} {
+		if schemasDiff != nil && (len(schemasDiff.Added) > 0 || len(schemasDiff.Deleted) > 0 || modifiedSchemasAffectValidation(schemasDiff.Modified)) {
+			return true
+		}
+	}
+
+	// the remaining keywords, except annotations, affect validation
+	remaining := *schemaDiff
+	remaining.NotDiff = nil
+	remaining.ItemsDiff = nil
+	remaining.AdditionalPropertiesDiff = nil
+	remaining.IfDiff = nil
+	remaining.ThenDiff = nil
+	remaining.ElseDiff = nil
+	remaining.UnevaluatedPropertiesDiff = nil
+	remaining.OneOfDiff = nil
+	remaining.AnyOfDiff = nil
+	remaining.AllOfDiff = nil
+	remaining.PrefixItemsDiff = nil
+	remaining.PropertiesDiff = nil
+	remaining.DefsDiff = nil
+	remaining.TitleDiff = nil
+	remaining.DescriptionDiff = nil
+	remaining.ExampleDiff = nil
+	remaining.ExternalDocsDiff = nil
+	remaining.ExtensionsDiff = nil
+	remaining.DeprecatedDiff = nil
+	remaining.XMLDiff = nil
+	return !remaining.Empty()
+}
+
+func modifiedSchemasAffectValidation(modifiedSchemas diff.ModifiedSchemas) bool {
+	for _, schemaDiff := range modifiedSchemas {
+		if affectsValidation(schemaDiff) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// BC: removing a media type from the request body is breaking
+// CL: adding a media type to the request body
+func TestRequestBodyMediaTypeUpdated(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestBodyMediaTypeUpdatedCheck), "../data/media-types/base.yaml", "../data/media-types/revision.yaml", nil)
+	require.ElementsMatch(t, []checker.BackwardCompatibilityError{
+		{
+			Id:          "request-body-media-type-removed",
+			Level:       checker.ERR,
+			Text:        "removed the request body media type 'text/plain'",
+			Operation:   "POST",
+			OperationId: "createPet",
+			Path:        "/pets",
+			Source:      "../data/media-types/revision.yaml",
+		},
+		{
+			Id:          "request-body-media-type-added",
+			Level:       checker.INFO,
+			Text:        "added the request body media type 'application/x-www-form-urlencoded'",
+			Operation:   "POST",
+			OperationId: "createPet",
+			Path:        "/pets",
+			Source:      "../data/media-types/revision.yaml",
+		},
+	}, errs)
+}
+
+// CL: adding a media type to a response
+func TestResponseMediaTypeAdded(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.ResponseMediaTypeUpdatedCheck), "../data/media-types/base.yaml", "../data/media-types/revision.yaml", nil)
+	require.Equal(t, checker.BackwardCompatibilityErrors{
+		{
+			Id:          "response-media-type-added",
+			Level:       checker.INFO,
+			Text:        "added the media type 'text/csv' for the response with the status '200'",
+			Operation:   "POST",
+			OperationId: "createPet",
+			Path:        "/pets",
+			Source:      "../data/media-types/revision.yaml",
+		},
+	}, errs)
+}
+
+// BC: changing the schema of a request body or a response in some media types but not in others is a potential breaking change
+func TestMediaTypeSchemaChangedPartially(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.MediaTypeSchemaChangedPartiallyCheck), "../data/media-types/base.yaml", "../data/media-types/revision.yaml", nil)
+	require.ElementsMatch(t, []checker.BackwardCompatibilityError{
+		{
+			Id:          "request-body-schema-changed-partially",
+			Level:       checker.WARN,
+			Text:        "the request body schema was changed in the media types 'application/json' but not in 'application/xml'",
+			Operation:   "POST",
+			OperationId: "createPet",
+			Path:        "/pets",
+			Source:      "../data/media-types/revision.yaml",
+		},
+		{
+			Id:          "response-schema-changed-partially",
+			Level:       checker.WARN,
+			Text:        "the schema of the response with the status '200' was changed in the media types 'application/json' but not in 'application/xml'",
+			Operation:   "POST",
+			OperationId: "createPet",
+			Path:        "/pets",
+			Source:      "../data/media-types/revision.yaml",
+		},
+	}, errs)
+}
+
+// BC: changing the schema of a request body in all of its media types is not reported as a partial change
+func TestMediaTypeSchemaChangedPartially_AllChanged(t *testing.T) {
+	s1, err := open("../data/media-types/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/media-types/base.yaml")
+	require.NoError(t, err)
+
+	for _, mediaType := range s2.Spec.Paths["/pets"].Post.RequestBody.Value.Content {
+		mediaType.Schema.Value.MaxLength = openapi3.Uint64Ptr(10)
+	}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Empty(t, checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.MediaTypeSchemaChangedPartiallyCheck), d, osm, checker.INFO))
+}
+
+// BC: changing only the description or the example of a request body schema in some of its media types is not reported as a partial change
+func TestMediaTypeSchemaChangedPartially_Annotations(t *testing.T) {
+	s1, err := open("../data/media-types/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/media-types/base.yaml")
+	require.NoError(t, err)
+
+	schema := s2.Spec.Paths["/pets"].Post.RequestBody.Value.Content["application/json"].Schema.Value
+	schema.Description = "changed"
+	schema.Properties["name"].Value.Example = "Rex"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Empty(t, checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.MediaTypeSchemaChangedPartiallyCheck), d, osm, checker.INFO))
+}
+
+// BC: removing a media type from a response is breaking
+func TestResponseMediaTypeRemoved(t *testing.T) {
+	s1, err := open("../data/media-types/revision.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/media-types/base.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponseMediaTypeRemoved), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-media-type-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "removed the media type 'text/csv' for the response with the status '200'", errs[0].Text)
+}
@@ -0,0 +1,14 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+// MinMaxPropertiesUpdatedCheck checks changes to the minProperties and maxProperties of request parameters, bodies, properties and response headers, see getMinChanges and getMaxChanges
+func MinMaxPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	return checkConstraintChanges(diffReport, operationsSources, config, func(request bool, schemaDiff *diff.SchemaDiff) []constraintChange {
+		return append(
+			getMinChanges("min-properties", request, schemaDiff.MinPropsDiff),
+			getMaxChanges("max-properties", request, schemaDiff.MaxPropsDiff)...)
+	})
+}
@@ -0,0 +1,36 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: increasing minProperties of a request property is breaking, and so is decreasing maxProperties
+func TestMinMaxPropertiesNarrowed(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MinMaxPropertiesUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "attributes").MinProps = 2
+		getItemSchema(s, "attributes").MaxProps = openapi3.Uint64Ptr(4)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("request-property-min-properties-increased", checker.ERR, "the minProperties was increased from '1' to '2' in the request property 'attributes'"), "attributes"),
+		withProperty(newError("request-property-max-properties-decreased", checker.ERR, "the maxProperties was decreased from '5' to '4' in the request property 'attributes'"), "attributes"),
+	}, errs)
+}
+
+// BC: decreasing minProperties of a response property is breaking, and so is unsetting maxProperties
+func TestMinMaxPropertiesWidened(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MinMaxPropertiesUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "attributes").MinProps = 0
+		getItemSchema(s, "attributes").MaxProps = nil
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("response-property-min-properties-decreased", checker.ERR, "the minProperties was decreased from '1' to '0' in the response property 'attributes' for the response status '200'"), "attributes"),
+		withProperty(newError("response-property-max-properties-unset", checker.ERR, "the maxProperties was unset from '5' in the response property 'attributes' for the response status '200'"), "attributes"),
+	}, errs)
+}
@@ -0,0 +1,40 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+/*
+MultipleOfUpdatedCheck checks changes to the multipleOf of request parameters, bodies, properties and response headers:
+- in requests, setting a multipleOf, or changing it to a number which isn't a divisor of the original one, rejects values which clients could send before
+- in responses, unsetting the multipleOf, or changing it to a number which isn't a multiple of the original one, allows values which clients may not expect
+*/
+func MultipleOfUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	return checkConstraintChanges(diffReport, operationsSources, config, func(request bool, schemaDiff *diff.SchemaDiff) []constraintChange {
+		multipleOfDiff := schemaDiff.MultipleOfDiff
+		if multipleOfDiff == nil {
+			return nil
+		}
+
+		from, fromOk := multipleOfDiff.From.(float64)
+		to, toOk := multipleOfDiff.To.(float64)
+
+		if request {
+			switch {
+			case !fromOk && toOk:
+				return newConstraintChange("multiple-of-set", ERR, to)
+			case fromOk && toOk && !isMultipleOf(from, to):
+				return newConstraintChange("multiple-of-changed", ERR, from, to)
+			}
+			return nil
+		}
+
+		switch {
+		case fromOk && !toOk:
+			return newConstraintChange("multiple-of-unset", ERR, from)
+		case fromOk && toOk && !isMultipleOf(to, from):
+			return newConstraintChange("multiple-of-changed", ERR, from, to)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,55 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: changing multipleOf to a number which isn't a divisor of the original one is breaking in requests
+func TestMultipleOfChanged(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MultipleOfUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "quantity").MultipleOf = openapi3.Float64Ptr(4)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("request-property-multiple-of-changed", checker.ERR, "the multipleOf was changed from '2.00' to '4.00' in the request property 'quantity'"), "quantity"),
+	}, errs)
+}
+
+// BC: changing multipleOf to a number which isn't a multiple of the original one is breaking in responses
+func TestMultipleOfChangedToDivisor(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MultipleOfUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "quantity").MultipleOf = openapi3.Float64Ptr(0.5)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("response-property-multiple-of-changed", checker.ERR, "the multipleOf was changed from '2.00' to '0.50' in the response property 'quantity' for the response status '200'"), "quantity"),
+	}, errs)
+}
+
+// BC: changing multipleOf to an unrelated number is breaking in both directions
+func TestMultipleOfChangedIncompatible(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MultipleOfUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "quantity").MultipleOf = openapi3.Float64Ptr(3)
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("request-property-multiple-of-changed", checker.ERR, "the multipleOf was changed from '2.00' to '3.00' in the request property 'quantity'"), "quantity"),
+		withProperty(newError("response-property-multiple-of-changed", checker.ERR, "the multipleOf was changed from '2.00' to '3.00' in the response property 'quantity' for the response status '200'"), "quantity"),
+	}, errs)
+}
+
+// BC: unsetting multipleOf is breaking in responses
+func TestMultipleOfUnset(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.MultipleOfUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "quantity").MultipleOf = nil
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("response-property-multiple-of-unset", checker.ERR, "the multipleOf was unset from '2.00' in the response property 'quantity' for the response status '200'"), "quantity"),
+	}, errs)
+}
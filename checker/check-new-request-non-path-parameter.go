@@ -43,6 +43,10 @@ func NewRequestNonPathParameterCheck(diffReport *diff.Diff, operationsSources *d
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								ParameterName:  paramName,
+								BaseSource:     config.getBaseSource(operationItem.Base),
+								RevisionSource: config.getRevisionSource(param.Value, operationItem.Revision),
 							})
 
 							break
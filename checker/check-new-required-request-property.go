@@ -39,6 +39,10 @@ func NewRequiredRequestPropertyCheck(diffReport *diff.Diff, operationsSources *d
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(parent), operationItem.Base),
+								RevisionSource: config.getRevisionSource(propertyItem, operationItem.Revision),
 							})
 						}
 					})
@@ -49,6 +49,11 @@ func NewRequiredRequestHeaderPropertyCheck(diffReport *diff.Diff, operationsSour
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								ParameterName:  paramName,
+								PropertyPath:   propertyFullName(propertyPath, newPropertyName),
+								BaseSource:     config.getBaseSource(baseSchema(parent), paramDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(newProperty, paramDiff.Revision, operationItem.Revision),
 							})
 						})
 				}
@@ -0,0 +1,75 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+/*
+PolymorphicSchemaUpdatedCheck reports the schemas which were added to or removed from the oneOf and anyOf lists of request and response bodies and their properties
+- removing a schema from a request breaks clients which send it, and adding one breaks clients which model the list as a closed union
+- removing a schema from a response breaks clients which model the list as a closed union, and adding one may break clients which don't expect it
+*/
+func PolymorphicSchemaUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			walkBodySchemaDiffs(operationItem, func(location bodySchemaLocation, schemaDiff *diff.SchemaDiff) {
+				for _, schemaList := range []struct {
+					keyword      string
+					listDiff     *diff.SchemaListDiff
+					getSchemaRef func(schema *openapi3.Schema) openapi3.SchemaRefs
+				}{
+					{"one-of", schemaDiff.OneOfDiff, func(schema *openapi3.Schema) openapi3.SchemaRefs { return schema.OneOf }},
+					{"any-of", schemaDiff.AnyOfDiff, func(schema *openapi3.Schema) openapi3.SchemaRefs { return schema.AnyOf }},
+				} {
+					if schemaList.listDiff == nil || schemaList.listDiff.Added == 0 && schemaList.listDiff.Deleted == 0 {
+						continue
+					}
+					if schemaDiff.Base == nil || schemaDiff.Base.Value == nil || schemaDiff.Revision == nil || schemaDiff.Revision.Value == nil {
+						continue
+					}
+
+					added, deleted := getSchemaListChanges(schemaList.getSchemaRef(schemaDiff.Base.Value), schemaList.getSchemaRef(schemaDiff.Revision.Value))
+
+					for _, change := range []struct {
+						suffix  string
+						schemas []string
+						level   Level
+					}{
+						{"added", added, location.level(ERR, WARN)},
+						{"removed", deleted, ERR},
+					} {
+						id := location.id(fmt.Sprintf("%s-%s", schemaList.keyword, change.suffix))
+						for _, schema := range change.schemas {
+							result = append(result, BackwardCompatibilityError{
+								Id:          id,
+								Level:       config.getLogLevel(id, change.level),
+								Text:        fmt.Sprintf(config.i18n(id), location.args(ColorizedValue(schema))...),
+								Operation:   operation,
+								OperationId: operationItem.Revision.OperationID,
+								Path:        path,
+								Source:      source,
+
+								PropertyPath:   location.propertyName,
+								BaseSource:     config.getBaseSource(baseSchema(schemaDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(schemaDiff), operationItem.Revision),
+							})
+						}
+					}
+				}
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+)
+
+// BC: removing a schema from the oneOf list of a request is breaking
+// BC: adding a schema to the oneOf list of a request is breaking
+// BC: adding a schema to the oneOf list of a response is a potential breaking change
+// BC: removing a schema from the anyOf list of a response is breaking
+func TestPolymorphicSchemaUpdated(t *testing.T) {
+	newError := newOperationError("POST", "createPet", "/pets", "../data/discriminator/revision.yaml")
+	errs := getChanges(t, singleCheckConfig(checker.PolymorphicSchemaUpdatedCheck), "../data/discriminator/base.yaml", "../data/discriminator/revision.yaml", nil)
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		newError("request-body-one-of-removed", checker.ERR, "removed '#/components/schemas/Lizard' from the 'oneOf' list of the request body"),
+		newError("request-body-one-of-added", checker.ERR, "added '#/components/schemas/Bird' to the 'oneOf' list of the request body"),
+		withProperty(newError("response-property-one-of-added", checker.WARN, "added '#/components/schemas/Bird' to the 'oneOf' list of the response property 'pet' for the response status '200'"), "pet"),
+		{
+			Id:          "response-body-any-of-removed",
+			Level:       checker.ERR,
+			Text:        "removed '#2' from the 'anyOf' list of the response body for the response status '200'",
+			Operation:   "GET",
+			OperationId: "getPetId",
+			Path:        "/pets/{id}",
+			Source:      "../data/discriminator/revision.yaml",
+		},
+	}, errs)
+}
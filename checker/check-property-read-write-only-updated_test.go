@@ -0,0 +1,143 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const readWriteOnlySpec = "../data/read-write-only/base.yaml"
+
+func getUserProperty(s *load.SpecInfo, property string) *openapi3.Schema {
+	return s.Spec.Components.Schemas["User"].Value.Properties[property].Value
+}
+
+// BC: changing a required request property to read-only is breaking
+func TestRequestRequiredPropertyBecameReadOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyReadOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "name").ReadOnly = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.BackwardCompatibilityError{
+		Id:          "request-required-property-became-read-only",
+		Text:        "the required request property 'name' became read-only",
+		Level:       checker.ERR,
+		Operation:   "POST",
+		OperationId: "createUser",
+		Path:        "/users",
+		Source:      "../data/read-write-only/base.yaml",
+
+		PropertyPath: "name",
+	}, errs[0])
+}
+
+// BC: changing an optional request property to read-only is a warning
+func TestRequestPropertyBecameReadOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyReadOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "nickname").ReadOnly = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-property-became-read-only", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "the request property 'nickname' became read-only", errs[0].Text)
+}
+
+// BC: changing a nested required request property to read-only is breaking
+func TestRequestNestedRequiredPropertyBecameReadOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyReadOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "address").Properties["city"].Value.ReadOnly = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-required-property-became-read-only", errs[0].Id)
+	require.Equal(t, "the required request property 'address/city' became read-only", errs[0].Text)
+}
+
+// BC: changing a required read-only request property to not read-only is breaking
+func TestRequestRequiredPropertyBecameNotReadOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyReadOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "id").ReadOnly = false
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-required-property-became-not-read-only", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "the required request property 'id' became not read-only", errs[0].Text)
+}
+
+// CL: changing an optional read-only request property to not read-only
+func TestRequestPropertyBecameNotReadOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyReadOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "createdAt").ReadOnly = false
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-property-became-not-read-only", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+	require.Equal(t, "the request property 'createdAt' became not read-only", errs[0].Text)
+}
+
+// BC: changing a request property to write-only is not breaking
+func TestRequestPropertyBecameWriteOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyReadOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "name").WriteOnly = true
+	})
+	require.Empty(t, errs)
+}
+
+// BC: changing a required response property to write-only is breaking
+func TestResponseRequiredPropertyBecameWriteOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.ResponsePropertyWriteOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "name").WriteOnly = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.BackwardCompatibilityError{
+		Id:          "response-required-property-became-write-only",
+		Text:        "the response required property 'name' became write-only for the status '201'",
+		Level:       checker.ERR,
+		Operation:   "POST",
+		OperationId: "createUser",
+		Path:        "/users",
+		Source:      "../data/read-write-only/base.yaml",
+
+		PropertyPath: "name",
+	}, errs[0])
+}
+
+// BC: changing an optional response property to write-only is a warning
+func TestResponsePropertyBecameWriteOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.ResponsePropertyWriteOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "nickname").WriteOnly = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-property-became-write-only", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "the response property 'nickname' became write-only for the status '201'", errs[0].Text)
+}
+
+// CL: changing an optional write-only response property to not write-only
+func TestResponsePropertyBecameNotWriteOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.ResponsePropertyWriteOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "secret").WriteOnly = false
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-property-became-not-write-only", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+	require.Equal(t, "the response property 'secret' became not write-only for the status '201'", errs[0].Text)
+}
+
+// BC: changing a required write-only response property to not write-only is reported only by ResponseRequiredPropertyBecameNonWriteOnlyCheck
+func TestResponseRequiredPropertyBecameNotWriteOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.ResponsePropertyWriteOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "password").WriteOnly = false
+	})
+	require.Empty(t, errs)
+}
+
+// BC: changing a response property to read-only is not breaking
+func TestResponsePropertyBecameReadOnly(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.ResponsePropertyWriteOnlyUpdatedCheck), readWriteOnlySpec, readWriteOnlySpec, func(_, s *load.SpecInfo) {
+		getUserProperty(s, "name").ReadOnly = true
+	})
+	require.Empty(t, errs)
+}
@@ -42,6 +42,9 @@ func RequestBodyBecameEnumCheck(diffReport *diff.Diff, operationsSources *diff.O
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), operationItem.Base),
+					RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), operationItem.Revision),
 				})
 			}
 		}
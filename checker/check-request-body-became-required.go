@@ -28,6 +28,9 @@ func RequestBodyBecameRequiredCheck(diffReport *diff.Diff, operationsSources *di
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(requestBodyOf(operationItem.Base), operationItem.Base),
+					RevisionSource: config.getRevisionSource(requestBodyOf(operationItem.Revision), operationItem.Revision),
 				})
 			}
 		}
@@ -49,6 +49,9 @@ func RequestBodyEnumValueRemovedCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						BaseSource:     config.getBaseSource(baseSchema(mediaTypeItem.SchemaDiff), operationItem.Base),
+						RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeItem.SchemaDiff), operationItem.Revision),
 					})
 				}
 			}
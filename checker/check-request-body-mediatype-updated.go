@@ -0,0 +1,62 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	requestBodyMediaTypeRemovedId = "request-body-media-type-removed"
+	requestBodyMediaTypeAddedId   = "request-body-media-type-added"
+)
+
+func RequestBodyMediaTypeUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			contentDiff := operationItem.RequestBodyDiff.ContentDiff
+
+			for _, mediaType := range contentDiff.MediaTypeDeleted {
+				result = append(result, BackwardCompatibilityError{
+					Id:          requestBodyMediaTypeRemovedId,
+					Level:       config.getLogLevel(requestBodyMediaTypeRemovedId, ERR),
+					Text:        fmt.Sprintf(config.i18n(requestBodyMediaTypeRemovedId), ColorizedValue(mediaType)),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					BaseSource:     config.getBaseSource(requestMediaTypeOf(operationItem.Base, mediaType), operationItem.Base),
+					RevisionSource: config.getRevisionSource(requestBodyOf(operationItem.Revision), operationItem.Revision),
+				})
+			}
+			for _, mediaType := range contentDiff.MediaTypeAdded {
+				result = append(result, BackwardCompatibilityError{
+					Id:          requestBodyMediaTypeAddedId,
+					Level:       config.getLogLevel(requestBodyMediaTypeAddedId, INFO),
+					Text:        fmt.Sprintf(config.i18n(requestBodyMediaTypeAddedId), ColorizedValue(mediaType)),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					BaseSource:     config.getBaseSource(requestBodyOf(operationItem.Base), operationItem.Base),
+					RevisionSource: config.getRevisionSource(requestMediaTypeOf(operationItem.Revision, mediaType), operationItem.Revision),
+				})
+			}
+		}
+	}
+	return result
+}
@@ -44,6 +44,10 @@ func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSourc
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramDiff.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramDiff.Revision, operationItem.Revision),
 						})
 					}
 
@@ -63,6 +67,11 @@ func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								ParameterName:  paramName,
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), paramDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), paramDiff.Revision, operationItem.Revision),
 							})
 						})
 				}
@@ -55,6 +55,11 @@ func RequestHeaderPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsS
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								ParameterName:  paramName,
+								PropertyPath:   changedRequiredPropertyName,
+								BaseSource:     config.getBaseSource(paramDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(schemaOf(paramDiff.SchemaDiff.Revision.Value.Properties[changedRequiredPropertyName]), paramDiff.Revision, operationItem.Revision),
 							})
 						}
 					}
@@ -81,6 +86,11 @@ func RequestHeaderPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsS
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									ParameterName:  paramName,
+									PropertyPath:   propertyFullName(propertyPath, propertyFullName(propertyName, changedRequiredPropertyName)),
+									BaseSource:     config.getBaseSource(baseSchema(propertyDiff), paramDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(schemaOf(propertyDiff.Revision.Value.Properties[changedRequiredPropertyName]), paramDiff.Revision, operationItem.Revision),
 								})
 							}
 						})
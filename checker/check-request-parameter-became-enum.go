@@ -43,6 +43,10 @@ func RequestParameterBecameEnumCheck(diffReport *diff.Diff, operationsSources *d
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 					})
 				}
 			}
@@ -41,6 +41,10 @@ func RequestParameterEnumValueRemovedCheck(diffReport *diff.Diff, operationsSour
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 						})
 					}
 				}
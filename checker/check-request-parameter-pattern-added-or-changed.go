@@ -48,6 +48,10 @@ func RequestParameterPatternAddedOrChangedCheck(diffReport *diff.Diff, operation
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 						})
 					} else {
 						result = append(result, BackwardCompatibilityError{
@@ -59,6 +63,10 @@ func RequestParameterPatternAddedOrChangedCheck(diffReport *diff.Diff, operation
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 						})
 					}
 				}
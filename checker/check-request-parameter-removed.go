@@ -30,6 +30,10 @@ func RequestParameterRemovedCheck(diffReport *diff.Diff, operationsSources *diff
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(parameterOf(operationItem.Base, paramLocation, paramName), operationItem.Base),
+						RevisionSource: config.getRevisionSource(operationItem.Revision),
 					})
 				}
 			}
@@ -0,0 +1,109 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	requestParameterRenamedId = "request-parameter-renamed"
+	requestParameterMovedId   = "request-parameter-moved"
+)
+
+// RequestParameterRenamedCheck reports request parameters which were moved to another location or renamed, see diff.RenamedParams
+// renaming a required parameter breaks the clients, renaming an optional one is reported as a warning like removing it
+// the changes in the parameter itself are checked like changes in a parameter which wasn't renamed
+func RequestParameterRenamedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for _, renamed := range operationItem.ParametersDiff.Renamed {
+				baseParam := operationItem.Base.Parameters.GetByInAndName(renamed.Base.In, renamed.Base.Name)
+				revisionParam := operationItem.Revision.Parameters.GetByInAndName(renamed.Revision.In, renamed.Revision.Name)
+
+				id := requestParameterRenamedId
+				args := []interface{}{ColorizedValue(renamed.Base.In), ColorizedValue(renamed.Base.Name), ColorizedValue(renamed.Revision.Name)}
+				if renamed.Moved() {
+					id = requestParameterMovedId
+					args = []interface{}{ColorizedValue(renamed.Base.In), ColorizedValue(renamed.Base.Name), ColorizedValue(renamed.Revision.In), ColorizedValue(renamed.Revision.Name)}
+				}
+
+				level := WARN
+				if isRequiredParam(baseParam) || isRequiredParam(revisionParam) {
+					level = ERR
+				}
+
+				result = append(result, BackwardCompatibilityError{
+					Id:          id,
+					Level:       config.getLogLevel(id, level),
+					Text:        fmt.Sprintf(config.i18n(id), args...),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					ParameterName:  renamed.Base.Name,
+					BaseSource:     config.getBaseSource(baseParam, operationItem.Base),
+					RevisionSource: config.getRevisionSource(revisionParam, operationItem.Revision),
+				})
+
+				if renamed.ParameterDiff == nil || baseParam == nil || revisionParam == nil {
+					continue
+				}
+
+				// the checks run on copies of the operations, so errors which aren't located at the parameter or its schema are located at the operation
+				for _, err := range runOperationChecks(config, operationChecks(), path, operation, getRenamedParamMethodDiff(operationItem, renamed, baseParam, revisionParam)) {
+					err.Source = source
+					if err.BaseSource == nil {
+						err.BaseSource = config.getBaseSource(operationItem.Base)
+					}
+					if err.RevisionSource == nil {
+						err.RevisionSource = config.getRevisionSource(operationItem.Revision)
+					}
+					result = append(result, err)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func isRequiredParam(param *openapi3.Parameter) bool {
+	return param != nil && param.Required
+}
+
+// getRenamedParamMethodDiff returns a diff of the operation which contains only the renamed parameter, as if it kept the revision name and location
+func getRenamedParamMethodDiff(operationItem *diff.MethodDiff, renamed *diff.RenamedParamDiff, baseParam, revisionParam *openapi3.Parameter) *diff.MethodDiff {
+	renamedBaseParam := *baseParam
+	renamedBaseParam.In = renamed.Revision.In
+	renamedBaseParam.Name = renamed.Revision.Name
+
+	base := *operationItem.Base
+	base.Parameters = openapi3.Parameters{{Value: &renamedBaseParam}}
+	revision := *operationItem.Revision
+	revision.Parameters = openapi3.Parameters{{Value: revisionParam}}
+
+	return &diff.MethodDiff{
+		ParametersDiff: &diff.ParametersDiff{
+			Modified: diff.ParamDiffByLocation{
+				renamed.Revision.In: diff.ParamDiffs{
+					renamed.Revision.Name: renamed.ParameterDiff,
+				},
+			},
+		},
+		Base:     &base,
+		Revision: &revision,
+	}
+}
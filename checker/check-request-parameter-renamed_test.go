@@ -0,0 +1,85 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// BC: renaming a required request parameter or moving it to another location is breaking, renaming an optional one is a warning
+func TestRequestParameterRenamed(t *testing.T) {
+	s1, err := open("../data/param-move/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/param-move/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterRenamedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 5)
+
+	levels := map[string]checker.Level{}
+	for _, err := range errs {
+		levels[err.Text] = err.Level
+	}
+	require.Equal(t, map[string]checker.Level{
+		"renamed the 'query' request parameter 'page_size' to 'pageSize'":                         checker.WARN,
+		"renamed the 'query' request parameter 'q' to 'search'":                                   checker.WARN,
+		"renamed the 'query' request parameter 'cursor' to 'after'":                               checker.WARN,
+		"moved the 'query' request parameter 'tenant' to the 'header' request parameter 'Tenant'": checker.ERR,
+		// the changes in the renamed parameter are checked regardless of the configured checks
+		"for the 'query' request parameter 'search', the maxLength was set to '100'": checker.WARN,
+	}, levels)
+}
+
+// BC: renamed request parameters aren't reported as removed and added
+func TestRequestParameterRenamed_NotRemoved(t *testing.T) {
+	s1, err := open("../data/param-move/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/param-move/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(checker.GetDefaultChecks(), d, osm, checker.INFO)
+
+	ids := map[string]int{}
+	for _, err := range errs {
+		ids[err.Id]++
+	}
+	require.Equal(t, map[string]int{
+		"request-parameter-renamed":        3,
+		"request-parameter-moved":          1,
+		"request-parameter-max-length-set": 1,
+		"request-parameter-removed":        1,
+		"new-optional-request-parameter":   1,
+	}, ids)
+}
+
+// BC: changes in a renamed request parameter are checked like changes in any other modified parameter
+func TestRequestParameterRenamed_ParameterChanged(t *testing.T) {
+	s1, err := open("../data/param-move/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/param-move/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	config := singleCheckConfig(checker.RequestParameterRenamedCheck)
+	config.Checks = append(config.Checks, checker.RequestParameterMaxLengthSetCheck)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+
+	maxLengthErrs := []checker.BackwardCompatibilityError{}
+	for _, err := range errs {
+		if err.Id == "request-parameter-max-length-set" {
+			maxLengthErrs = append(maxLengthErrs, err)
+		}
+	}
+	require.Len(t, maxLengthErrs, 1)
+	require.Equal(t, "for the 'query' request parameter 'search', the maxLength was set to '100'", maxLengthErrs[0].Text)
+	require.Equal(t, "/books", maxLengthErrs[0].Path)
+	require.Equal(t, "GET", maxLengthErrs[0].Operation)
+	require.Equal(t, "listBooks", maxLengthErrs[0].OperationId)
+}
@@ -46,6 +46,10 @@ func RequestParameterRequiredValueUpdatedCheck(diffReport *diff.Diff, operations
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 					})
 				}
 			}
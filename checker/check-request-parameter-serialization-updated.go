@@ -0,0 +1,122 @@
+package checker
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	requestParameterStyleChangedId          = "request-parameter-style-changed"
+	requestParameterExplodeChangedId        = "request-parameter-explode-changed"
+	requestParameterAllowReservedDisabledId = "request-parameter-allow-reserved-disabled"
+)
+
+/*
+RequestParameterSerializationUpdatedCheck compares the effective serialization of request parameters: https://swagger.io/docs/specification/serialization/
+a style, explode or allowReserved which isn't specified is replaced by its default in the parameter's location, so that making a default explicit, or removing it, isn't reported
+*/
+func RequestParameterSerializationUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for paramLocation, paramItems := range operationItem.ParametersDiff.Modified {
+				for paramName, paramItem := range paramItems {
+					if paramItem.StyleDiff == nil && paramItem.ExplodeDiff == nil && paramItem.AllowReservedDiff == nil {
+						continue
+					}
+					if paramItem.Base.Schema == nil || paramItem.Revision.Schema == nil {
+						// parameters with content are serialized according to their media type
+						continue
+					}
+
+					newError := func(id string, args ...interface{}) BackwardCompatibilityError {
+						return BackwardCompatibilityError{
+							Id:          id,
+							Level:       config.getLogLevel(id, ERR),
+							Text:        fmt.Sprintf(config.i18n(id), append([]interface{}{ColorizedValue(paramLocation), ColorizedValue(paramName)}, args...)...),
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
+						}
+					}
+
+					baseStyle := getParameterStyle(paramItem.Base)
+					revisionStyle := getParameterStyle(paramItem.Revision)
+					baseExplode := getParameterExplode(paramItem.Base)
+					revisionExplode := getParameterExplode(paramItem.Revision)
+
+					if baseStyle != revisionStyle {
+						if isParameterStyleSignificant(paramItem.Base) || isParameterStyleSignificant(paramItem.Revision) {
+							result = append(result, newError(requestParameterStyleChangedId, ColorizedValue(baseStyle), ColorizedValue(revisionStyle)))
+						}
+					} else if baseExplode != revisionExplode && isParameterExplodable(paramItem.Revision) {
+						// the default explode depends on the style, so it is reported only if the style didn't change
+						result = append(result, newError(requestParameterExplodeChangedId, ColorizedValue(strconv.FormatBool(baseExplode)), ColorizedValue(strconv.FormatBool(revisionExplode))))
+					}
+
+					if paramItem.Base.AllowReserved && !paramItem.Revision.AllowReserved && paramLocation == openapi3.ParameterInQuery {
+						result = append(result, newError(requestParameterAllowReservedDisabledId))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// getParameterStyle returns the style of a parameter, or the default style of its location: form for query and cookie parameters, and simple for path and header parameters
+func getParameterStyle(param *openapi3.Parameter) string {
+	if param.Style != "" {
+		return param.Style
+	}
+	switch param.In {
+	case openapi3.ParameterInQuery, openapi3.ParameterInCookie:
+		return openapi3.SerializationForm
+	default:
+		return openapi3.SerializationSimple
+	}
+}
+
+// getParameterExplode returns the explode of a parameter, or its default which is true only for the form style
+func getParameterExplode(param *openapi3.Parameter) bool {
+	if param.Explode != nil {
+		return *param.Explode
+	}
+	return getParameterStyle(param) == openapi3.SerializationForm
+}
+
+// isParameterExplodable indicates whether explode affects the serialization of a parameter: only arrays and objects are exploded
+func isParameterExplodable(param *openapi3.Parameter) bool {
+	if param.Schema == nil || param.Schema.Value == nil {
+		return false
+	}
+	switch param.Schema.Value.Type {
+	case "array", "object", "":
+		return true
+	default:
+		return false
+	}
+}
+
+// isParameterStyleSignificant indicates whether the style affects the serialization of a parameter: the query styles differ only for arrays and objects, while the path styles label and matrix prefix primitives too
+func isParameterStyleSignificant(param *openapi3.Parameter) bool {
+	return isParameterExplodable(param) || param.In == openapi3.ParameterInPath
+}
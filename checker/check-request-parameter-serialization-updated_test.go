@@ -0,0 +1,155 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func getSerializationParam(s *load.SpecInfo, location, name string) *openapi3.Parameter {
+	return s.Spec.Paths["/items/{id}"].Get.Parameters.GetByInAndName(location, name)
+}
+
+func checkSerialization(t *testing.T, s1, s2 *load.SpecInfo) checker.BackwardCompatibilityErrors {
+	t.Helper()
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	return checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), d, osm, checker.INFO)
+}
+
+// CL: changing the style of a request parameter
+func TestRequestParameterStyleChanged(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInQuery, "ids").Style = openapi3.SerializationPipeDelimited
+
+	errs := checkSerialization(t, s1, s2)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.BackwardCompatibilityError{
+		Id:          "request-parameter-style-changed",
+		Level:       checker.ERR,
+		Text:        "for the 'query' request parameter 'ids', the style was changed from 'form' to 'pipeDelimited'",
+		Operation:   "GET",
+		OperationId: "getItems",
+		Path:        "/items/{id}",
+		Source:      "../data/parameter-serialization/base.yaml",
+
+		ParameterName: "ids",
+	}, errs[0])
+}
+
+// CL: changing the style of a request parameter to deepObject
+func TestRequestParameterStyleChangedToDeepObject(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInQuery, "filter").Style = openapi3.SerializationDeepObject
+
+	errs := checkSerialization(t, s1, s2)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-parameter-style-changed", errs[0].Id)
+	require.Equal(t, "for the 'query' request parameter 'filter', the style was changed from 'form' to 'deepObject'", errs[0].Text)
+}
+
+// CL: changing the explode of a request parameter
+func TestRequestParameterExplodeChanged(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInQuery, "ids").Explode = openapi3.BoolPtr(false)
+	getSerializationParam(s2, openapi3.ParameterInHeader, "X-Tags").Explode = openapi3.BoolPtr(true)
+
+	errs := checkSerialization(t, s1, s2)
+	require.Len(t, errs, 2)
+	require.ElementsMatch(t, []string{
+		"for the 'query' request parameter 'ids', explode was changed from 'true' to 'false'",
+		"for the 'header' request parameter 'X-Tags', explode was changed from 'false' to 'true'",
+	}, []string{errs[0].Text, errs[1].Text})
+}
+
+// CL: specifying the default style and explode of request parameters explicitly
+func TestRequestParameterSerializationDefaultsExplicit(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	ids := getSerializationParam(s2, openapi3.ParameterInQuery, "ids")
+	ids.Style = openapi3.SerializationForm
+	ids.Explode = openapi3.BoolPtr(true)
+	tags := getSerializationParam(s2, openapi3.ParameterInHeader, "X-Tags")
+	tags.Style = openapi3.SerializationSimple
+	tags.Explode = openapi3.BoolPtr(false)
+
+	require.Empty(t, checkSerialization(t, s1, s2))
+	require.Empty(t, checkSerialization(t, s2, s1))
+}
+
+// CL: changing the explode of a primitive request parameter
+func TestRequestParameterExplodeChangedPrimitive(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInPath, "id").Explode = openapi3.BoolPtr(true)
+
+	require.Empty(t, checkSerialization(t, s1, s2))
+}
+
+// CL: changing the style of a primitive query request parameter
+func TestRequestParameterStyleChangedPrimitive(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInQuery, "name").Style = openapi3.SerializationPipeDelimited
+
+	require.Empty(t, checkSerialization(t, s1, s2))
+}
+
+// CL: changing the style of a primitive path request parameter
+func TestRequestParameterStyleChangedPrimitivePath(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInPath, "id").Style = openapi3.SerializationMatrix
+
+	errs := checkSerialization(t, s1, s2)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-parameter-style-changed", errs[0].Id)
+	require.Equal(t, "for the 'path' request parameter 'id', the style was changed from 'simple' to 'matrix'", errs[0].Text)
+}
+
+// CL: disallowing reserved characters in a query request parameter
+func TestRequestParameterAllowReservedDisabled(t *testing.T) {
+	s1, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/parameter-serialization/base.yaml")
+	require.NoError(t, err)
+
+	getSerializationParam(s2, openapi3.ParameterInQuery, "name").AllowReserved = false
+
+	errs := checkSerialization(t, s1, s2)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-parameter-allow-reserved-disabled", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "the 'query' request parameter 'name' no longer allows reserved characters", errs[0].Text)
+
+	// allowing reserved characters doesn't change the serialization of values which clients already send
+	require.Empty(t, checkSerialization(t, s2, s1))
+}
@@ -57,6 +57,10 @@ func RequestParameterXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, ope
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 						})
 						continue
 					}
@@ -70,6 +74,10 @@ func RequestParameterXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, ope
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 						})
 						continue
 					}
@@ -90,6 +98,10 @@ func RequestParameterXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, ope
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							ParameterName:  paramName,
+							BaseSource:     config.getBaseSource(paramItem.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(paramItem.Revision, operationItem.Revision),
 						})
 					}
 				}
@@ -51,6 +51,10 @@ func RequestParameterDefaultValueChanged(diffReport *diff.Diff, operationsSource
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(paramDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(paramDiff.Revision, operationItem.Revision),
 					})
 				}
 			}
@@ -47,6 +47,10 @@ func RequestParameterMaxDecreasedCheck(diffReport *diff.Diff, operationsSources
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(paramDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(paramDiff.Revision, operationItem.Revision),
 					})
 				}
 			}
@@ -44,6 +44,10 @@ func RequestParameterMaxSetCheck(diffReport *diff.Diff, operationsSources *diff.
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(paramDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(paramDiff.Revision, operationItem.Revision),
 					})
 				}
 			}
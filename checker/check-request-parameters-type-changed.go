@@ -39,27 +39,15 @@ func RequestParameterTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 						continue
 					}
 
-					if formatDiff != nil && (formatDiff.To == nil || formatDiff.To == "") {
+					if typeDiff != nil && (typeDiff.To == nil || typeDiff.To == "") {
+						// a parameter without a type accepts any value
 						continue
 					}
 
-					if formatDiff != nil && paramDiff.Revision.Schema.Value.Type == "string" &&
-						(formatDiff.From == "date" && formatDiff.To == "date-time" ||
-							formatDiff.From == "time" && formatDiff.To == "date-time") {
+					if typeDiff == nil && !isFormatChangeBreakingInRequest(paramDiff.Revision.Schema.Value.Type, formatDiff.From, formatDiff.To) {
 						continue
 					}
 
-					if formatDiff != nil && paramDiff.Revision.Schema.Value.Type == "number" &&
-						(formatDiff.From == "float" && formatDiff.To == "double") {
-						continue
-					}
-
-					if formatDiff != nil && paramDiff.Revision.Schema.Value.Type == "integer" &&
-						(formatDiff.From == "int32" && formatDiff.To == "int64" ||
-							formatDiff.From == "int32" && formatDiff.To == "bigint" ||
-							formatDiff.From == "int64" && formatDiff.To == "bigint") {
-						continue
-					}
 					source := (*operationsSources)[operationItem.Revision]
 
 					if typeDiff == nil {
@@ -77,6 +65,10 @@ func RequestParameterTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(paramDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(paramDiff.Revision, operationItem.Revision),
 					})
 				}
 			}
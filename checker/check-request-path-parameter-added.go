@@ -34,6 +34,10 @@ func NewRequestPathParameterCheck(diffReport *diff.Diff, operationsSources *diff
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						ParameterName:  paramName,
+						BaseSource:     config.getBaseSource(operationItem.Base),
+						RevisionSource: config.getRevisionSource(parameterOf(operationItem.Revision, paramLocation, paramName), operationItem.Revision),
 					})
 				}
 			}
@@ -0,0 +1,86 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	requestBodyAdditionalPropertiesDisallowedId     = "request-body-additional-properties-disallowed"
+	requestPropertyAdditionalPropertiesDisallowedId = "request-property-additional-properties-disallowed"
+)
+
+// RequestPropertyAdditionalPropertiesDisallowedCheck reports request bodies and request properties which no longer allow additional properties, since clients which send them will be rejected
+func RequestPropertyAdditionalPropertiesDisallowedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff == nil {
+					continue
+				}
+
+				if additionalPropertiesDisallowed(mediaTypeDiff.SchemaDiff) {
+					result = append(result, BackwardCompatibilityError{
+						Id:          requestBodyAdditionalPropertiesDisallowedId,
+						Level:       config.getLogLevel(requestBodyAdditionalPropertiesDisallowedId, ERR),
+						Text:        config.i18n(requestBodyAdditionalPropertiesDisallowedId),
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      source,
+
+						BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), operationItem.Base),
+						RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), operationItem.Revision),
+					})
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						if !additionalPropertiesDisallowed(propertyDiff) {
+							return
+						}
+						if propertyDiff.Revision.Value.ReadOnly {
+							return
+						}
+
+						result = append(result, BackwardCompatibilityError{
+							Id:          requestPropertyAdditionalPropertiesDisallowedId,
+							Level:       config.getLogLevel(requestPropertyAdditionalPropertiesDisallowedId, ERR),
+							Text:        fmt.Sprintf(config.i18n(requestPropertyAdditionalPropertiesDisallowedId), ColorizedValue(propertyFullName(propertyPath, propertyName))),
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      source,
+
+							PropertyPath:   propertyFullName(propertyPath, propertyName),
+							BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
+						})
+					})
+			}
+		}
+	}
+	return result
+}
+
+// additionalPropertiesDisallowed indicates whether additionalProperties was changed from true, or unset, to false
+func additionalPropertiesDisallowed(schemaDiff *diff.SchemaDiff) bool {
+	return schemaDiff.AdditionalPropertiesAllowedDiff.CompareWithDefault(true, false, true)
+}
@@ -0,0 +1,68 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+)
+
+// BC: setting additionalProperties to false in a request body or in a request property is breaking
+func TestRequestPropertyAdditionalPropertiesDisallowed(t *testing.T) {
+	newError := newOperationError("POST", "createPet", "/pets", "../data/additional-properties/revision.yaml")
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyAdditionalPropertiesDisallowedCheck), "../data/additional-properties/base.yaml", "../data/additional-properties/revision.yaml", nil)
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		newError("request-body-additional-properties-disallowed", checker.ERR, "the request body no longer allows additional properties"),
+		withProperty(newError("request-property-additional-properties-disallowed", checker.ERR, "the request property 'metadata' no longer allows additional properties"), "metadata"),
+	}, errs)
+}
+
+// BC: adding a required property to the additionalProperties schema of a request property is breaking
+func TestAdditionalProperties_NewRequiredRequestProperty(t *testing.T) {
+	newError := newOperationError("POST", "createPet", "/pets", "../data/additional-properties/revision.yaml")
+	errs := getChanges(t, singleCheckConfig(checker.NewRequiredRequestPropertyCheck), "../data/additional-properties/base.yaml", "../data/additional-properties/revision.yaml", nil)
+	require.Equal(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("new-required-request-property", checker.ERR, "added the new required request property 'labels/additionalProperties/color'"), "labels/additionalProperties/color"),
+	}, errs)
+}
+
+// BC: removing a required property from the additionalProperties schema of a response property is breaking
+func TestAdditionalProperties_ResponseRequiredPropertyRemoved(t *testing.T) {
+	newError := newOperationError("POST", "createPet", "/pets", "../data/additional-properties/revision.yaml")
+	errs := getChanges(t, singleCheckConfig(checker.ResponseRequiredPropertyRemovedCheck), "../data/additional-properties/base.yaml", "../data/additional-properties/revision.yaml", nil)
+	require.Equal(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("response-required-property-removed", checker.ERR, "removed the required property 'owners/additionalProperties/email' from the response with the '200' status"), "owners/additionalProperties/email"),
+	}, errs)
+}
+
+// BC: decreasing the maxLength of a property inside a not schema of a request body is not breaking
+func TestNot_RequestPropertyMaxLengthDecreased(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyMaxLengthDecreasedCheck), "../data/additional-properties/base.yaml", "../data/additional-properties/revision.yaml", nil)
+	require.Empty(t, errs)
+}
+
+// BC: modifying the not schema of a request body is a warning
+func TestNot_RequestBodyNotModified(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.UncheckedNotWarnCheck), "../data/additional-properties/base.yaml", "../data/additional-properties/revision.yaml", nil)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-body-not-modified", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "modified the not schema of the request body", errs[0].Text)
+	require.Equal(t, "It is a warning because a change inside not has the opposite effect of the same change outside of it, so it isn't checked like other changes", errs[0].Comment)
+}
+
+// BC: adding a required request property, removing a request property or removing a required response property inside a not schema is not breaking
+func TestNot_PropertyChecks(t *testing.T) {
+	config := singleCheckConfig(checker.NewRequiredRequestPropertyCheck)
+	config.Checks = append(config.Checks, checker.RequestPropertyRemovedCheck, checker.ResponseRequiredPropertyRemovedCheck, checker.ResponsePropertyBecameOptionalCheck)
+	errs := getChanges(t, config, "../data/not/base.yaml", "../data/not/revision.yaml", nil)
+	require.Empty(t, errs)
+}
+
+// BC: modifying the not schemas of a request body and of a response body is a warning
+func TestNot_PropertyChecksWarning(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.UncheckedNotWarnCheck), "../data/not/base.yaml", "../data/not/revision.yaml", nil)
+	require.Len(t, errs, 2)
+	require.Equal(t, "request-body-not-modified", errs[0].Id)
+	require.Equal(t, "response-body-not-modified", errs[1].Id)
+}
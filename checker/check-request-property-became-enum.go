@@ -47,6 +47,10 @@ func RequestPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSources *di
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							PropertyPath:   propertyFullName(propertyPath, propertyName),
+							BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 						})
 					})
 			}
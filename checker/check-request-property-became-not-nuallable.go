@@ -40,6 +40,9 @@ func RequestPropertyBecameNotNullableCheck(diffReport *diff.Diff, operationsSour
 						Operation: operation,
 						Path:      path,
 						Source:    source,
+
+						BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), operationItem.Base),
+						RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), operationItem.Revision),
 					})
 				}
 
@@ -61,6 +64,10 @@ func RequestPropertyBecameNotNullableCheck(diffReport *diff.Diff, operationsSour
 							Operation: operation,
 							Path:      path,
 							Source:    source,
+
+							PropertyPath:   propertyFullName(propertyPath, propertyName),
+							BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 						})
 					})
 			}
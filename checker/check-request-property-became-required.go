@@ -49,6 +49,10 @@ func RequestPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsSources
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							PropertyPath:   changedRequiredPropertyName,
+							BaseSource:     config.getBaseSource(schemaOf(mediaTypeDiff.SchemaDiff.Base.Value.Properties[changedRequiredPropertyName]), operationItem.Base),
+							RevisionSource: config.getRevisionSource(schemaOf(mediaTypeDiff.SchemaDiff.Revision.Value.Properties[changedRequiredPropertyName]), operationItem.Revision),
 						})
 					}
 				}
@@ -79,6 +83,10 @@ func RequestPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsSources
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyFullName(propertyName, changedRequiredPropertyName)),
+								BaseSource:     config.getBaseSource(schemaOf(propertyDiff.Base.Value.Properties[changedRequiredPropertyName]), operationItem.Base),
+								RevisionSource: config.getRevisionSource(schemaOf(propertyDiff.Revision.Value.Properties[changedRequiredPropertyName]), operationItem.Revision),
 							})
 						}
 					})
@@ -0,0 +1,118 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"golang.org/x/exp/slices"
+)
+
+/*
+RequestPropertyDefaultValueChangedCheck checks the default values of optional request bodies, their optional properties and the optional properties of request headers, including nested properties
+the server uses the default for every client which omits the value, so changing it is breaking, and adding or removing it is a warning
+the default values of request parameters themselves are checked by RequestParameterDefaultValueChanged
+*/
+func RequestPropertyDefaultValueChangedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			// appendDefaultValueDiff reports the default value diff of a schema with the id of the element, for example: request-property-default-value-added
+			// the parameter name and the property path are empty if the schema isn't within them
+			appendDefaultValueDiff := func(element string, schemaDiff *diff.SchemaDiff, paramName string, propertyPath string, args ...interface{}) {
+				defaultValueDiff := schemaDiff.DefaultDiff
+				if defaultValueDiff.Empty() {
+					return
+				}
+
+				var id string
+				level := WARN
+				switch {
+				case defaultValueDiff.From == nil:
+					id = element + "-default-value-added"
+					args = append(args, ColorizedValue(defaultValueDiff.To))
+				case defaultValueDiff.To == nil:
+					id = element + "-default-value-removed"
+					args = append(args, ColorizedValue(defaultValueDiff.From))
+				default:
+					id, level = element+"-default-value-changed", ERR
+					args = append(args, ColorizedValue(defaultValueDiff.From), ColorizedValue(defaultValueDiff.To))
+				}
+
+				result = append(result, BackwardCompatibilityError{
+					Id:          id,
+					Level:       config.getLogLevel(id, level),
+					Text:        fmt.Sprintf(config.i18n(id), args...),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					PropertyPath:   propertyPath,
+					ParameterName:  paramName,
+					BaseSource:     config.getBaseSource(baseSchema(schemaDiff), operationItem.Base),
+					RevisionSource: config.getRevisionSource(revisionSchema(schemaDiff), operationItem.Revision),
+				})
+			}
+
+			if operationItem.RequestBodyDiff != nil &&
+				operationItem.RequestBodyDiff.ContentDiff != nil {
+				requestBodyRequired := operationItem.Revision.RequestBody != nil &&
+					operationItem.Revision.RequestBody.Value != nil &&
+					operationItem.Revision.RequestBody.Value.Required
+
+				for mediaType, mediaTypeDiff := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+					if mediaTypeDiff.SchemaDiff == nil {
+						continue
+					}
+
+					if !requestBodyRequired {
+						appendDefaultValueDiff("request-body", mediaTypeDiff.SchemaDiff, "", "", ColorizedValue(mediaType))
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							if isOptionalRequestProperty(propertyName, propertyDiff, parent) {
+								fullName := propertyFullName(propertyPath, propertyName)
+								appendDefaultValueDiff("request-property", propertyDiff, "", fullName, ColorizedValue(fullName))
+							}
+						})
+				}
+			}
+
+			if operationItem.ParametersDiff != nil {
+				for paramName, paramDiff := range operationItem.ParametersDiff.Modified[openapi3.ParameterInHeader] {
+					CheckModifiedPropertiesDiff(
+						paramDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							if isOptionalRequestProperty(propertyName, propertyDiff, parent) {
+								fullName := propertyFullName(propertyPath, propertyName)
+								appendDefaultValueDiff("request-header-property", propertyDiff, paramName, fullName, ColorizedValue(paramName), ColorizedValue(fullName))
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// isOptionalRequestProperty indicates whether clients may omit a request property: it isn't required before or after the change, and it isn't read-only, since read-only properties aren't sent at all
+func isOptionalRequestProperty(propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) bool {
+	if propertyName == "" {
+		// subschemas of allOf, anyOf and oneOf aren't properties
+		return false
+	}
+	return !slices.Contains(parent.Base.Value.Required, propertyName) &&
+		!slices.Contains(parent.Revision.Value.Required, propertyName) &&
+		!propertyDiff.Revision.Value.ReadOnly
+}
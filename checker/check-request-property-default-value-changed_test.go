@@ -0,0 +1,107 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const defaultValuesSpec = "../data/default-values/base.yaml"
+
+func getOrderSchema(s *load.SpecInfo) *openapi3.Schema {
+	return s.Spec.Paths["/orders"].Post.RequestBody.Value.Content["application/json"].Schema.Value
+}
+
+// BC: changing the default value of an optional request property is breaking
+func TestRequestPropertyDefaultValueChanged(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		getOrderSchema(s).Properties["quantity"].Value.Default = 2.0
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.BackwardCompatibilityError{
+		Id:          "request-property-default-value-changed",
+		Text:        "for the request property 'quantity', default value was changed from '1.00' to '2.00'",
+		Level:       checker.ERR,
+		Operation:   "POST",
+		OperationId: "createOrder",
+		Path:        "/orders",
+		Source:      "../data/default-values/base.yaml",
+
+		PropertyPath: "quantity",
+	}, errs[0])
+}
+
+// BC: adding a default value to an optional request property is a warning
+func TestRequestPropertyDefaultValueAdded(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		getOrderSchema(s).Properties["currency"].Value.Default = "USD"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-property-default-value-added", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "for the request property 'currency', default value 'USD' was added", errs[0].Text)
+}
+
+// BC: removing the default value of an optional request property is a warning
+func TestRequestPropertyDefaultValueRemoved(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		getOrderSchema(s).Properties["quantity"].Value.Default = nil
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-property-default-value-removed", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "for the request property 'quantity', default value '1.00' was removed", errs[0].Text)
+}
+
+// BC: changing the default value of a nested optional request property is breaking
+func TestRequestNestedPropertyDefaultValueChanged(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		getOrderSchema(s).Properties["shipping"].Value.Properties["method"].Value.Default = "express"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-property-default-value-changed", errs[0].Id)
+	require.Equal(t, "for the request property 'shipping/method', default value was changed from 'standard' to 'express'", errs[0].Text)
+}
+
+// BC: changing the default value of a required or read-only request property is not breaking
+func TestRequestRequiredPropertyDefaultValueChanged(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		getOrderSchema(s).Properties["item"].Value.Default = "pen"
+		getOrderSchema(s).Properties["status"].Value.Default = "draft"
+	})
+	require.Empty(t, errs)
+}
+
+// BC: adding a default value to an optional request body is a warning
+func TestRequestBodyDefaultValueAdded(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		getOrderSchema(s).Default = map[string]interface{}{"currency": "EUR"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-body-default-value-added", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "the 'application/json' request body default value 'map[currency:EUR]' was added", errs[0].Text)
+}
+
+// BC: changing the default value of an optional property of a request header is breaking
+func TestRequestHeaderPropertyDefaultValueChanged(t *testing.T) {
+	errs := getChanges(t, singleCheckConfig(checker.RequestPropertyDefaultValueChangedCheck), defaultValuesSpec, defaultValuesSpec, func(_, s *load.SpecInfo) {
+		s.Spec.Paths["/orders"].Post.Parameters.GetByInAndName(openapi3.ParameterInHeader, "X-Options").Schema.Value.Properties["priority"].Value.Default = "high"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.BackwardCompatibilityError{
+		Id:          "request-header-property-default-value-changed",
+		Text:        "for the 'X-Options' request header's property 'priority', default value was changed from 'normal' to 'high'",
+		Level:       checker.ERR,
+		Operation:   "POST",
+		OperationId: "createOrder",
+		Path:        "/orders",
+		Source:      "../data/default-values/base.yaml",
+
+		PropertyPath:  "priority",
+		ParameterName: "X-Options",
+	}, errs[0])
+}
@@ -44,6 +44,10 @@ func RequestPropertyEnumValueRemovedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 						}
 					})
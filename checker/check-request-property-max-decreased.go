@@ -38,6 +38,9 @@ func RequestPropertyMaxDecreasedCheck(diffReport *diff.Diff, operationsSources *
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), operationItem.Revision),
 							})
 						}
 					}
@@ -69,6 +72,10 @@ func RequestPropertyMaxDecreasedCheck(diffReport *diff.Diff, operationsSources *
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							PropertyPath:   propertyFullName(propertyPath, propertyName),
+							BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 						})
 					})
 			}
@@ -47,6 +47,10 @@ func RequestPropertyPatternAddedOrChangedCheck(diffReport *diff.Diff, operations
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 						} else {
 							result = append(result, BackwardCompatibilityError{
@@ -58,6 +62,10 @@ func RequestPropertyPatternAddedOrChangedCheck(diffReport *diff.Diff, operations
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 						}
 					})
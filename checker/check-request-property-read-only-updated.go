@@ -0,0 +1,85 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	requestPropertyBecameReadOnlyId            = "request-property-became-read-only"
+	requestRequiredPropertyBecameReadOnlyId    = "request-required-property-became-read-only"
+	requestPropertyBecameNotReadOnlyId         = "request-property-became-not-read-only"
+	requestRequiredPropertyBecameNotReadOnlyId = "request-required-property-became-not-read-only"
+)
+
+/*
+RequestPropertyReadOnlyUpdatedCheck checks request properties whose readOnly changed, read-only properties don't appear in requests:
+- a property which became read-only is ignored or rejected by the server, which is breaking if the property was required
+- a property which is no longer read-only appears in requests, which is breaking if the property is required, since clients must now send it
+the severity is set by the required list of the parent schema, the changes of writeOnly don't affect requests
+*/
+func RequestPropertyReadOnlyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for _, mediaTypeDiff := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+				if mediaTypeDiff.SchemaDiff == nil {
+					continue
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						readOnlyDiff := propertyDiff.ReadOnlyDiff
+						if readOnlyDiff == nil {
+							return
+						}
+
+						var id string
+						var level Level
+						if readOnlyDiff.To == true {
+							id, level = requestPropertyBecameReadOnlyId, WARN
+							if slices.Contains(parent.Base.Value.Required, propertyName) {
+								id, level = requestRequiredPropertyBecameReadOnlyId, ERR
+							}
+						} else {
+							id, level = requestPropertyBecameNotReadOnlyId, INFO
+							if slices.Contains(parent.Revision.Value.Required, propertyName) {
+								id, level = requestRequiredPropertyBecameNotReadOnlyId, ERR
+							}
+						}
+
+						result = append(result, BackwardCompatibilityError{
+							Id:          id,
+							Level:       config.getLogLevel(id, level),
+							Text:        fmt.Sprintf(config.i18n(id), ColorizedValue(propertyFullName(propertyPath, propertyName))),
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      source,
+
+							PropertyPath:   propertyFullName(propertyPath, propertyName),
+							BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
+						})
+					})
+			}
+		}
+	}
+	return result
+}
@@ -37,6 +37,10 @@ func RequestPropertyRemovedCheck(diffReport *diff.Diff, operationsSources *diff.
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(propertyItem, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(parent), operationItem.Revision),
 							})
 						}
 					})
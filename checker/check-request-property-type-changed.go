@@ -39,6 +39,9 @@ func RequestPropertyTypeChangedCheck(diffReport *diff.Diff, operationsSources *d
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), operationItem.Revision),
 						})
 					}
 				}
@@ -62,6 +65,10 @@ func RequestPropertyTypeChangedCheck(diffReport *diff.Diff, operationsSources *d
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 						}
 					})
@@ -88,7 +95,7 @@ func breakingTypeFormatChangedInRequestProperty(typeDiff *diff.ValueDiff, format
 	}
 
 	if formatDiff != nil {
-		return !isFormatContained(schemaDiff.Revision.Value.Type, formatDiff.To, formatDiff.From)
+		return isFormatChangeBreakingInRequest(schemaDiff.Revision.Value.Type, formatDiff.From, formatDiff.To)
 	}
 
 	return false
@@ -57,6 +57,10 @@ func RequestPropertyXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, oper
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 							return
 						}
@@ -70,6 +74,10 @@ func RequestPropertyXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, oper
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 							return
 						}
@@ -93,6 +101,10 @@ func RequestPropertyXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, oper
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), operationItem.Revision),
 							})
 						}
 					})
@@ -45,6 +45,10 @@ func ResponseHeaderBecameOptional(diffReport *diff.Diff, operationsSources *diff
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						HeaderName:     headerName,
+						BaseSource:     config.getBaseSource(responseHeaderOf(responseDiff.Base, headerName), responseDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(responseHeaderOf(responseDiff.Revision, headerName), responseDiff.Revision, operationItem.Revision),
 					})
 				}
 			}
@@ -0,0 +1,40 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	responseHeaderEnumValueAddedCheckId   = "response-header-enum-value-added"
+	responseHeaderEnumValueRemovedCheckId = "response-header-enum-value-removed"
+)
+
+// ResponseHeaderEnumValueUpdatedCheck checks enum values which were added to or removed from response headers
+// new values may be unexpected for clients while removed values are never sent
+func ResponseHeaderEnumValueUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+
+	for _, headerDiff := range getResponseHeaderSchemaDiffs(diffReport, operationsSources) {
+		enumDiff := headerDiff.schemaDiff.EnumDiff
+		if enumDiff == nil {
+			continue
+		}
+
+		for _, enumVal := range enumDiff.Added {
+			err := headerDiff.newError(config, responseHeaderEnumValueAddedCheckId, WARN,
+				ColorizedValue(enumVal),
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(headerDiff.responseStatus))
+			err.Comment = config.i18n("response-header-enum-value-added-comment")
+			result = append(result, err)
+		}
+
+		for _, enumVal := range enumDiff.Deleted {
+			result = append(result, headerDiff.newError(config, responseHeaderEnumValueRemovedCheckId, INFO,
+				ColorizedValue(enumVal),
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(headerDiff.responseStatus)))
+		}
+	}
+	return result
+}
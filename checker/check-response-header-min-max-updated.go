@@ -0,0 +1,69 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	responseHeaderMaxIncreasedCheckId       = "response-header-max-increased"
+	responseHeaderMaxUnsetCheckId           = "response-header-max-unset"
+	responseHeaderMinDecreasedCheckId       = "response-header-min-decreased"
+	responseHeaderMinUnsetCheckId           = "response-header-min-unset"
+	responseHeaderMaxLengthIncreasedCheckId = "response-header-max-length-increased"
+	responseHeaderMaxLengthUnsetCheckId     = "response-header-max-length-unset"
+	responseHeaderMinLengthDecreasedCheckId = "response-header-min-length-decreased"
+)
+
+// ResponseHeaderMinMaxUpdatedCheck checks response headers whose range of values was widened
+// clients may rely on the values sent by the server being within the original limits
+func ResponseHeaderMinMaxUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+
+	for _, headerDiff := range getResponseHeaderSchemaDiffs(diffReport, operationsSources) {
+		schemaDiff := headerDiff.schemaDiff
+
+		newLimitError := func(id string, limitDiff *diff.ValueDiff) BackwardCompatibilityError {
+			return headerDiff.newError(config, id, ERR,
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(limitDiff.From),
+				ColorizedValue(limitDiff.To),
+				ColorizedValue(headerDiff.responseStatus))
+		}
+
+		newUnsetError := func(id string, limitDiff *diff.ValueDiff) BackwardCompatibilityError {
+			return headerDiff.newError(config, id, ERR,
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(limitDiff.From),
+				ColorizedValue(headerDiff.responseStatus))
+		}
+
+		if maxDiff := schemaDiff.MaxDiff; maxDiff != nil && maxDiff.From != nil {
+			if maxDiff.To == nil {
+				result = append(result, newUnsetError(responseHeaderMaxUnsetCheckId, maxDiff))
+			} else if IsIncreasedValue(maxDiff) {
+				result = append(result, newLimitError(responseHeaderMaxIncreasedCheckId, maxDiff))
+			}
+		}
+
+		if minDiff := schemaDiff.MinDiff; minDiff != nil && minDiff.From != nil {
+			if minDiff.To == nil {
+				result = append(result, newUnsetError(responseHeaderMinUnsetCheckId, minDiff))
+			} else if IsDecreasedValue(minDiff) {
+				result = append(result, newLimitError(responseHeaderMinDecreasedCheckId, minDiff))
+			}
+		}
+
+		if maxLengthDiff := schemaDiff.MaxLengthDiff; maxLengthDiff != nil && maxLengthDiff.From != nil {
+			if maxLengthDiff.To == nil {
+				result = append(result, newUnsetError(responseHeaderMaxLengthUnsetCheckId, maxLengthDiff))
+			} else if IsIncreasedValue(maxLengthDiff) {
+				result = append(result, newLimitError(responseHeaderMaxLengthIncreasedCheckId, maxLengthDiff))
+			}
+		}
+
+		if minLengthDiff := schemaDiff.MinLengthDiff; minLengthDiff != nil && minLengthDiff.From != nil && minLengthDiff.To != nil && IsDecreasedValue(minLengthDiff) {
+			result = append(result, newLimitError(responseHeaderMinLengthDecreasedCheckId, minLengthDiff))
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	responseHeaderPatternAddedCheckId   = "response-header-pattern-added"
+	responseHeaderPatternChangedCheckId = "response-header-pattern-changed"
+	responseHeaderPatternRemovedCheckId = "response-header-pattern-removed"
+)
+
+// ResponseHeaderPatternUpdatedCheck checks changes in the pattern of response headers
+// adding a pattern only restricts the values sent by the server, while changing or removing it may send values that clients don't expect
+func ResponseHeaderPatternUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+
+	for _, headerDiff := range getResponseHeaderSchemaDiffs(diffReport, operationsSources) {
+		patternDiff := headerDiff.schemaDiff.PatternDiff
+		if patternDiff == nil {
+			continue
+		}
+
+		from := anyPattern2empty(patternDiff.From)
+		to := anyPattern2empty(patternDiff.To)
+
+		switch {
+		case from == to:
+			continue
+		case from == "":
+			result = append(result, headerDiff.newError(config, responseHeaderPatternAddedCheckId, INFO,
+				ColorizedValue(to),
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(headerDiff.responseStatus)))
+		case to == "":
+			result = append(result, headerDiff.newError(config, responseHeaderPatternRemovedCheckId, WARN,
+				ColorizedValue(from),
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(headerDiff.responseStatus)))
+		default:
+			err := headerDiff.newError(config, responseHeaderPatternChangedCheckId, WARN,
+				ColorizedValue(headerDiff.headerName),
+				ColorizedValue(from),
+				ColorizedValue(to),
+				ColorizedValue(headerDiff.responseStatus))
+			err.Comment = config.i18n("pattern-changed-warn-comment")
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// anyPattern2empty returns an empty string for patterns which match any string
+func anyPattern2empty(pattern interface{}) string {
+	patternStr, _ := pattern.(string)
+	if patternStr == ".*" {
+		return ""
+	}
+	return patternStr
+}
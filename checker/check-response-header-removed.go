@@ -42,6 +42,10 @@ func ResponseHeaderRemoved(diffReport *diff.Diff, operationsSources *diff.Operat
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							HeaderName:     headerName,
+							BaseSource:     config.getBaseSource(responseHeaderOf(responseDiff.Base, headerName), responseDiff.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(responseDiff.Revision, operationItem.Revision),
 						})
 					} else {
 						result = append(result, BackwardCompatibilityError{
@@ -52,6 +56,10 @@ func ResponseHeaderRemoved(diffReport *diff.Diff, operationsSources *diff.Operat
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							HeaderName:     headerName,
+							BaseSource:     config.getBaseSource(responseHeaderOf(responseDiff.Base, headerName), responseDiff.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(responseDiff.Revision, operationItem.Revision),
 						})
 					}
 				}
@@ -0,0 +1,214 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const rateLimitHeader = "X-RateLimit-Limit"
+
+func getRateLimitSchema(s *load.SpecInfo) *openapi3.Schema {
+	return s.Spec.Paths[installCommandPath].Get.Responses["default"].Value.Headers[rateLimitHeader].Value.Schema.Value
+}
+
+func getResponseHeaderChanges(t *testing.T, check checker.BackwardCompatibilityCheck, modify func(schema1, schema2 *openapi3.Schema)) checker.BackwardCompatibilityErrors {
+	t.Helper()
+	return getChanges(t, singleCheckConfig(check), "../data/openapi-test1.yaml", "../data/openapi-test1.yaml", func(s1, s2 *load.SpecInfo) {
+		modify(getRateLimitSchema(s1), getRateLimitSchema(s2))
+	})
+}
+
+// BC: changing the type of a response header is breaking
+func TestResponseHeaderTypeChanged(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderTypeChangedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema2.Type = "string"
+	})
+	require.Equal(t, checker.BackwardCompatibilityErrors{{
+		Id:        "response-header-type-changed",
+		Text:      "the response header 'X-RateLimit-Limit' type/format changed from 'integer'/'none' to 'string'/'none' for the response status 'default'",
+		Level:     checker.ERR,
+		Operation: "GET",
+		Path:      installCommandPath,
+		Source:    "../data/openapi-test1.yaml",
+
+		HeaderName: "X-RateLimit-Limit",
+	}}, errs)
+}
+
+// BC: changing the type of a response header from number to integer is not breaking
+func TestResponseHeaderTypeNarrowed(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderTypeChangedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "number"
+	})
+	require.Empty(t, errs)
+}
+
+// BC: changing the format of a response header to a wider format is breaking
+func TestResponseHeaderFormatChanged(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderTypeChangedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Format = "int32"
+		schema2.Format = "int64"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "the response header 'X-RateLimit-Limit' type/format changed from 'integer'/'int32' to 'integer'/'int64' for the response status 'default'", errs[0].Text)
+}
+
+// BC: adding an enum value to a response header is breaking as warn
+func TestResponseHeaderEnumValueAdded(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderEnumValueUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.Enum = []interface{}{"100", "1000"}
+		schema2.Enum = []interface{}{"100", "1000", "10000"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-enum-value-added", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "added the new '10000' enum value to the response header 'X-RateLimit-Limit' for the response status 'default'", errs[0].Text)
+	require.NotEmpty(t, errs[0].Comment)
+}
+
+// BC: removing an enum value from a response header is not breaking
+func TestResponseHeaderEnumValueRemoved(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderEnumValueUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.Enum = []interface{}{"100", "1000"}
+		schema2.Enum = []interface{}{"100"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-enum-value-removed", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+}
+
+// BC: increasing the max of a response header is breaking
+func TestResponseHeaderMaxIncreased(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Max = openapi3.Float64Ptr(100)
+		schema2.Max = openapi3.Float64Ptr(200)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-max-increased", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "the response header 'X-RateLimit-Limit' max was increased from '100.00' to '200.00' for the response status 'default'", errs[0].Text)
+}
+
+// BC: decreasing the max of a response header is not breaking
+func TestResponseHeaderMaxDecreased(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Max = openapi3.Float64Ptr(200)
+		schema2.Max = openapi3.Float64Ptr(100)
+	})
+	require.Empty(t, errs)
+}
+
+// BC: decreasing the min of a response header is breaking
+func TestResponseHeaderMinDecreased(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Min = openapi3.Float64Ptr(10)
+		schema2.Min = openapi3.Float64Ptr(0)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-min-decreased", errs[0].Id)
+}
+
+// BC: removing the max of a response header is breaking
+func TestResponseHeaderMaxUnset(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Max = openapi3.Float64Ptr(100)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-max-unset", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "the response header 'X-RateLimit-Limit' max was unset from '100.00' for the response status 'default'", errs[0].Text)
+}
+
+// BC: removing the min of a response header is breaking
+func TestResponseHeaderMinUnset(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Min = openapi3.Float64Ptr(10)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-min-unset", errs[0].Id)
+	require.Equal(t, "the response header 'X-RateLimit-Limit' min was unset from '10.00' for the response status 'default'", errs[0].Text)
+}
+
+// BC: increasing the maxLength of a response header is breaking
+func TestResponseHeaderMaxLengthIncreased(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.MaxLength = openapi3.Uint64Ptr(10)
+		schema2.MaxLength = openapi3.Uint64Ptr(20)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-max-length-increased", errs[0].Id)
+	require.Equal(t, "the response header 'X-RateLimit-Limit' maxLength was increased from '10' to '20' for the response status 'default'", errs[0].Text)
+}
+
+// BC: removing the maxLength of a response header is breaking
+func TestResponseHeaderMaxLengthUnset(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.MaxLength = openapi3.Uint64Ptr(10)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-max-length-unset", errs[0].Id)
+}
+
+// BC: decreasing the minLength of a response header is breaking
+func TestResponseHeaderMinLengthDecreased(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderMinMaxUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.MinLength = 5
+		schema2.MinLength = 1
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-min-length-decreased", errs[0].Id)
+}
+
+// BC: adding a pattern to a response header is not breaking
+func TestResponseHeaderPatternAdded(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderPatternUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema2.Pattern = "^[0-9]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-pattern-added", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+	require.Equal(t, "added the pattern '^[0-9]+$' to the response header 'X-RateLimit-Limit' for the response status 'default'", errs[0].Text)
+}
+
+// BC: changing the pattern of a response header is breaking as warn
+func TestResponseHeaderPatternChanged(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderPatternUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.Pattern = "^[0-9]+$"
+		schema2.Pattern = "^[0-9a-f]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-pattern-changed", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+	require.Equal(t, "changed the pattern of the response header 'X-RateLimit-Limit' from '^[0-9]+$' to '^[0-9a-f]+$' for the response status 'default'", errs[0].Text)
+}
+
+// BC: removing the pattern of a response header is breaking as warn
+func TestResponseHeaderPatternRemoved(t *testing.T) {
+	errs := getResponseHeaderChanges(t, checker.ResponseHeaderPatternUpdatedCheck, func(schema1, schema2 *openapi3.Schema) {
+		schema1.Type = "string"
+		schema2.Type = "string"
+		schema1.Pattern = "^[0-9]+$"
+		schema2.Pattern = ".*"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-header-pattern-removed", errs[0].Id)
+	require.Equal(t, checker.WARN, errs[0].Level)
+}
@@ -0,0 +1,33 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+const responseHeaderTypeChangedCheckId = "response-header-type-changed"
+
+// ResponseHeaderTypeChangedCheck checks changes in the type or format of response headers which clients may not be able to parse
+func ResponseHeaderTypeChangedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+
+	for _, headerDiff := range getResponseHeaderSchemaDiffs(diffReport, operationsSources) {
+		schemaDiff := headerDiff.schemaDiff
+		typeDiff := schemaDiff.TypeDiff
+		formatDiff := schemaDiff.FormatDiff
+
+		// header values are plain text so the media type restrictions of the response body don't apply
+		if !breakingTypeFormatChangedInResponseProperty(typeDiff, formatDiff, "", schemaDiff) {
+			continue
+		}
+
+		typeDiff, formatDiff = fillEmptyTypeAndFormatDiffs(typeDiff, schemaDiff, formatDiff)
+		result = append(result, headerDiff.newError(config, responseHeaderTypeChangedCheckId, ERR,
+			ColorizedValue(headerDiff.headerName),
+			empty2none(typeDiff.From),
+			empty2none(formatDiff.From),
+			empty2none(typeDiff.To),
+			empty2none(formatDiff.To),
+			ColorizedValue(headerDiff.responseStatus)))
+	}
+	return result
+}
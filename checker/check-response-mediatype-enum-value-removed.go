@@ -52,6 +52,9 @@ func ResponseMediaTypeEnumValueRemovedCheck(diffReport *diff.Diff, operationsSou
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							BaseSource:     config.getBaseSource(baseSchema(mediaTypeItem.SchemaDiff), responseItems.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeItem.SchemaDiff), responseItems.Revision, operationItem.Revision),
 						})
 					}
 
@@ -1,47 +1,17 @@
 package checker
 
 import (
-	"fmt"
-
 	"github.com/tufin/oasdiff/diff"
 )
 
+// ResponseMediaTypeRemoved reports the media types which were removed from responses
+//
+// Deprecated: use ResponseMediaTypeUpdatedCheck, which also reports the media types which were added to responses
 func ResponseMediaTypeRemoved(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
 	result := make([]BackwardCompatibilityError, 0)
-	if diffReport.PathsDiff == nil {
-		return result
-	}
-	for path, pathItem := range diffReport.PathsDiff.Modified {
-		if pathItem.OperationsDiff == nil {
-			continue
-		}
-		for operation, operationItem := range pathItem.OperationsDiff.Modified {
-			if operationItem.ResponsesDiff == nil {
-				continue
-			}
-			if operationItem.ResponsesDiff.Modified == nil {
-				continue
-			}
-			source := (*operationsSources)[operationItem.Revision]
-			for responseStatus, responsesDiff := range operationItem.ResponsesDiff.Modified {
-				if responsesDiff.ContentDiff == nil {
-					continue
-				}
-				if responsesDiff.ContentDiff.MediaTypeDeleted == nil {
-					continue
-				}
-				for _, mediaType := range responsesDiff.ContentDiff.MediaTypeDeleted {
-					result = append(result, BackwardCompatibilityError{
-						Id:          "response-media-type-removed",
-						Level:       ERR,
-						Text:        fmt.Sprintf(config.i18n("response-media-type-removed"), ColorizedValue(mediaType), ColorizedValue(responseStatus)),
-						Operation:   operation,
-						OperationId: operationItem.Revision.OperationID,
-						Path:        path,
-						Source:      source,
-					})
-				}
-			}
+	for _, err := range ResponseMediaTypeUpdatedCheck(diffReport, operationsSources, config) {
+		if err.Id == responseMediaTypeRemovedId {
+			result = append(result, err)
 		}
 	}
 	return result
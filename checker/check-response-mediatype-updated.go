@@ -0,0 +1,67 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	responseMediaTypeRemovedId = "response-media-type-removed"
+	responseMediaTypeAddedId   = "response-media-type-added"
+)
+
+func ResponseMediaTypeUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+			if operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responsesDiff := range operationItem.ResponsesDiff.Modified {
+				if responsesDiff.ContentDiff == nil {
+					continue
+				}
+				for _, mediaType := range responsesDiff.ContentDiff.MediaTypeDeleted {
+					result = append(result, BackwardCompatibilityError{
+						Id:          responseMediaTypeRemovedId,
+						Level:       config.getLogLevel(responseMediaTypeRemovedId, ERR),
+						Text:        fmt.Sprintf(config.i18n(responseMediaTypeRemovedId), ColorizedValue(mediaType), ColorizedValue(responseStatus)),
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      source,
+
+						BaseSource:     config.getBaseSource(responseMediaTypeOf(responsesDiff.Base, mediaType), responsesDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(responsesDiff.Revision, operationItem.Revision),
+					})
+				}
+				for _, mediaType := range responsesDiff.ContentDiff.MediaTypeAdded {
+					result = append(result, BackwardCompatibilityError{
+						Id:          responseMediaTypeAddedId,
+						Level:       config.getLogLevel(responseMediaTypeAddedId, INFO),
+						Text:        fmt.Sprintf(config.i18n(responseMediaTypeAddedId), ColorizedValue(mediaType), ColorizedValue(responseStatus)),
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      source,
+
+						BaseSource:     config.getBaseSource(responsesDiff.Base, operationItem.Base),
+						RevisionSource: config.getRevisionSource(responseMediaTypeOf(responsesDiff.Revision, mediaType), responsesDiff.Revision, operationItem.Revision),
+					})
+				}
+			}
+		}
+	}
+	return result
+}
@@ -49,6 +49,10 @@ func ResponseOptionalPropertyRemovedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(propertyItem, responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(parent), responseDiff.Revision, operationItem.Revision),
 							})
 						})
 				}
@@ -46,6 +46,9 @@ func ResponsePropertyBecameNullableCheck(diffReport *diff.Diff, operationsSource
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      source,
+
+							BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), responseDiff.Base, operationItem.Base),
+							RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), responseDiff.Revision, operationItem.Revision),
 						})
 					}
 
@@ -68,6 +71,10 @@ func ResponsePropertyBecameNullableCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
 							})
 						})
 				}
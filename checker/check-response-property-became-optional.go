@@ -52,6 +52,10 @@ func ResponsePropertyBecameOptionalCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   changedRequiredPropertyName,
+								BaseSource:     config.getBaseSource(schemaOf(mediaTypeDiff.SchemaDiff.Base.Value.Properties[changedRequiredPropertyName]), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(schemaOf(mediaTypeDiff.SchemaDiff.Revision.Value.Properties[changedRequiredPropertyName]), responseDiff.Revision, operationItem.Revision),
 							})
 						}
 					}
@@ -82,6 +86,10 @@ func ResponsePropertyBecameOptionalCheck(diffReport *diff.Diff, operationsSource
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									PropertyPath:   propertyFullName(propertyPath, propertyFullName(propertyName, changedRequiredPropertyName)),
+									BaseSource:     config.getBaseSource(schemaOf(propertyDiff.Base.Value.Properties[changedRequiredPropertyName]), responseDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(schemaOf(propertyDiff.Revision.Value.Properties[changedRequiredPropertyName]), responseDiff.Revision, operationItem.Revision),
 								})
 							}
 						})
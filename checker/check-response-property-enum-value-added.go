@@ -49,6 +49,10 @@ func ResponsePropertyEnumValueAddedCheck(diffReport *diff.Diff, operationsSource
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									PropertyPath:   propertyFullName(propertyPath, propertyName),
+									BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
 								})
 							}
 						})
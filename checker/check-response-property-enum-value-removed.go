@@ -47,6 +47,10 @@ func ResponseParameterEnumValueRemovedCheck(diffReport *diff.Diff, operationsSou
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									PropertyPath:   propertyFullName(propertyPath, propertyName),
+									BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
 								})
 							}
 						})
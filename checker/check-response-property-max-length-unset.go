@@ -40,6 +40,9 @@ func ResponsePropertyMaxLengthUnsetCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), responseDiff.Revision, operationItem.Revision),
 							})
 						}
 					}
@@ -67,6 +70,10 @@ func ResponsePropertyMaxLengthUnsetCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
 							})
 						})
 				}
@@ -41,6 +41,9 @@ func ResponsePropertyMinDecreasedCheck(diffReport *diff.Diff, operationsSources
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), responseDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), responseDiff.Revision, operationItem.Revision),
 								})
 							}
 						}
@@ -73,6 +76,10 @@ func ResponsePropertyMinDecreasedCheck(diffReport *diff.Diff, operationsSources
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
 							})
 						})
 				}
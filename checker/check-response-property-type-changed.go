@@ -44,6 +44,9 @@ func ResponsePropertyTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								BaseSource:     config.getBaseSource(baseSchema(mediaTypeDiff.SchemaDiff), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(mediaTypeDiff.SchemaDiff), responseDiff.Revision, operationItem.Revision),
 							})
 						}
 					}
@@ -72,6 +75,9 @@ func ResponsePropertyTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
 								})
 							}
 						})
@@ -89,7 +95,7 @@ func breakingTypeFormatChangedInResponseProperty(typeDiff *diff.ValueDiff, forma
 	}
 
 	if formatDiff != nil {
-		return !isFormatContained(schemaDiff.Revision.Value.Type, formatDiff.From, formatDiff.To)
+		return isFormatChangeBreakingInResponse(schemaDiff.Revision.Value.Type, formatDiff.From, formatDiff.To)
 	}
 
 	return false
@@ -101,24 +107,6 @@ func isTypeContained(type1, type2 interface{}, mediaType string) bool {
 		(type1 == "string" && !isJsonMediaType(mediaType) && mediaType != "application/xml") // string can change to anything, unless it's json or xml
 }
 
-// isFormatContained checks if format2 is contained in format1
-func isFormatContained(schemaType string, format1, format2 interface{}) bool {
-
-	switch schemaType {
-	case "number":
-		return format1 == "double" && format2 == "float"
-	case "integer":
-		return (format1 == "int64" && format2 == "int32") ||
-			(format1 == "bigint" && format2 == "int32") ||
-			(format1 == "bigint" && format2 == "int64")
-	case "string":
-		return (format1 == "date-time" && format2 == "date" ||
-			format1 == "date-time" && format2 == "time")
-	}
-
-	return false
-}
-
 func isJsonMediaType(mediaType string) bool {
 	return mediaType == "application/json" ||
 		(strings.HasPrefix(mediaType, "application/vnd.") && strings.HasSuffix(mediaType, "+json"))
@@ -0,0 +1,90 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	responsePropertyBecameWriteOnlyId         = "response-property-became-write-only"
+	responseRequiredPropertyBecameWriteOnlyId = "response-required-property-became-write-only"
+	responsePropertyBecameNotWriteOnlyId      = "response-property-became-not-write-only"
+)
+
+/*
+ResponsePropertyWriteOnlyUpdatedCheck checks response properties whose writeOnly changed, write-only properties don't appear in responses:
+- a property which became write-only disappears from responses, which is breaking if the property was required
+- an optional property which is no longer write-only appears in responses, required properties are checked by ResponseRequiredPropertyBecameNonWriteOnlyCheck
+the severity is set by the required list of the parent schema, the changes of readOnly don't affect responses
+*/
+func ResponsePropertyWriteOnlyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				for _, mediaTypeDiff := range responseDiff.ContentDiff.MediaTypeModified {
+					if mediaTypeDiff.SchemaDiff == nil {
+						continue
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							writeOnlyDiff := propertyDiff.WriteOnlyDiff
+							if writeOnlyDiff == nil {
+								return
+							}
+
+							var id string
+							var level Level
+							if writeOnlyDiff.To == true {
+								id, level = responsePropertyBecameWriteOnlyId, WARN
+								if slices.Contains(parent.Base.Value.Required, propertyName) {
+									id, level = responseRequiredPropertyBecameWriteOnlyId, ERR
+								}
+							} else {
+								if slices.Contains(parent.Base.Value.Required, propertyName) {
+									// processed by the ResponseRequiredPropertyBecameNonWriteOnlyCheck check
+									return
+								}
+								id, level = responsePropertyBecameNotWriteOnlyId, INFO
+							}
+
+							result = append(result, BackwardCompatibilityError{
+								Id:          id,
+								Level:       config.getLogLevel(id, level),
+								Text:        fmt.Sprintf(config.i18n(id), ColorizedValue(propertyFullName(propertyPath, propertyName)), ColorizedValue(responseStatus)),
+								Operation:   operation,
+								OperationId: operationItem.Revision.OperationID,
+								Path:        path,
+								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(propertyDiff), responseDiff.Base, operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(propertyDiff), responseDiff.Revision, operationItem.Revision),
+							})
+						})
+				}
+			}
+		}
+	}
+	return result
+}
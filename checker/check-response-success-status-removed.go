@@ -55,6 +55,9 @@ func ResponseSuccessRemoved(diffReport *diff.Diff, operationsSources *diff.Opera
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      source,
+
+						BaseSource:     config.getBaseSource(responseOf(operationItem.Base, responseStatus), operationItem.Base),
+						RevisionSource: config.getRevisionSource(operationItem.Revision),
 					})
 				}
 
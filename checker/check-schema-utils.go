@@ -0,0 +1,168 @@
+package checker
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// bodySchemaLocation identifies the request body, a response body, or a property within them, in the checks which apply to both directions
+type bodySchemaLocation struct {
+	request        bool
+	propertyName   string // the full name of the property, or empty for the body itself
+	responseStatus string
+}
+
+// id returns the check id of a change in this location, for example: request-body-one-of-added or response-property-one-of-added
+func (location bodySchemaLocation) id(change string) string {
+	direction := "response"
+	if location.request {
+		direction = "request"
+	}
+	kind := "body"
+	if location.propertyName != "" {
+		kind = "property"
+	}
+	return fmt.Sprintf("%s-%s-%s", direction, kind, change)
+}
+
+// args appends the property name and the response status to the arguments of a change, in the order which the messages expect them
+func (location bodySchemaLocation) args(args ...interface{}) []interface{} {
+	if location.propertyName != "" {
+		args = append(args, ColorizedValue(location.propertyName))
+	}
+	if !location.request {
+		args = append(args, ColorizedValue(location.responseStatus))
+	}
+	return args
+}
+
+// level returns the level for the direction of the location
+func (location bodySchemaLocation) level(requestLevel, responseLevel Level) Level {
+	if location.request {
+		return requestLevel
+	}
+	return responseLevel
+}
+
+/*
+walkBodySchemaDiffs calls the processor with the schema diffs of the request body and the response bodies of an operation, and of their properties
+read-only request properties and write-only response properties are skipped since they don't appear in the respective direction
+*/
+func walkBodySchemaDiffs(operationItem *diff.MethodDiff, processor func(location bodySchemaLocation, schemaDiff *diff.SchemaDiff)) {
+	walk := func(contentDiff *diff.ContentDiff, request bool, responseStatus string) {
+		if contentDiff == nil {
+			return
+		}
+		for _, mediaTypeDiff := range contentDiff.MediaTypeModified {
+			if mediaTypeDiff.SchemaDiff == nil {
+				continue
+			}
+			processor(bodySchemaLocation{request: request, responseStatus: responseStatus}, mediaTypeDiff.SchemaDiff)
+
+			CheckModifiedPropertiesDiff(
+				mediaTypeDiff.SchemaDiff,
+				func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+					if propertyDiff.Revision == nil || propertyDiff.Revision.Value == nil {
+						return
+					}
+					if request && propertyDiff.Revision.Value.ReadOnly ||
+						!request && propertyDiff.Revision.Value.WriteOnly {
+						return
+					}
+					processor(bodySchemaLocation{
+						request:        request,
+						propertyName:   propertyFullName(propertyPath, propertyName),
+						responseStatus: responseStatus,
+					}, propertyDiff)
+				})
+		}
+	}
+
+	if operationItem.RequestBodyDiff != nil {
+		walk(operationItem.RequestBodyDiff.ContentDiff, true, "")
+	}
+
+	if operationItem.ResponsesDiff != nil {
+		for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+			walk(responseDiff.ContentDiff, false, responseStatus)
+		}
+	}
+}
+
+/*
+getSchemaListChanges returns the schemas which were added to and deleted from a oneOf or anyOf list
+schemas with a $ref are identified by their $ref, inline schemas by their title or by their position in the list
+like diff.SchemaListDiff, a single inline schema which was replaced by another is considered modified rather than added and deleted
+*/
+func getSchemaListChanges(base, revision openapi3.SchemaRefs) ([]string, []string) {
+	added := getRefsDifference(revision, base)
+	deleted := getRefsDifference(base, revision)
+
+	addedInline := getInlineSchemasDifference(revision, base)
+	deletedInline := getInlineSchemasDifference(base, revision)
+	if len(addedInline) == 1 && len(deletedInline) == 1 {
+		return added, deleted
+	}
+
+	return append(added, addedInline...), append(deleted, deletedInline...)
+}
+
+// getRefsDifference returns the $refs in the first list which don't appear in the second
+func getRefsDifference(schemaRefs1, schemaRefs2 openapi3.SchemaRefs) []string {
+	refs2 := map[string]struct{}{}
+	for _, schemaRef := range schemaRefs2 {
+		if schemaRef != nil && schemaRef.Ref != "" {
+			refs2[schemaRef.Ref] = struct{}{}
+		}
+	}
+
+	result := []string{}
+	for _, schemaRef := range schemaRefs1 {
+		if schemaRef == nil || schemaRef.Ref == "" {
+			continue
+		}
+		if _, ok := refs2[schemaRef.Ref]; !ok {
+			result = append(result, schemaRef.Ref)
+		}
+	}
+	return result
+}
+
+// getInlineSchemasDifference returns the inline schemas in the first list which don't have an identical schema in the second
+func getInlineSchemasDifference(schemaRefs1, schemaRefs2 openapi3.SchemaRefs) []string {
+	matched := map[int]struct{}{}
+	result := []string{}
+
+	for index1, schemaRef1 := range schemaRefs1 {
+		if schemaRef1 == nil || schemaRef1.Ref != "" {
+			continue
+		}
+
+		found := false
+		for index2, schemaRef2 := range schemaRefs2 {
+			if _, ok := matched[index2]; ok || schemaRef2 == nil || schemaRef2.Ref != "" {
+				continue
+			}
+			if reflect.DeepEqual(schemaRef1, schemaRef2) {
+				matched[index2] = struct{}{}
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, getInlineSchemaName(schemaRef1, index1))
+		}
+	}
+	return result
+}
+
+func getInlineSchemaName(schemaRef *openapi3.SchemaRef, index int) string {
+	if schemaRef.Value != nil && schemaRef.Value.Title != "" {
+		return schemaRef.Value.Title
+	}
+	return fmt.Sprintf("#%d", index+1)
+}
@@ -0,0 +1,51 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+const notModifiedCommentId = "not-modified-comment"
+
+/*
+UncheckedNotWarnCheck warns about changes to the not schemas of request and response bodies and their properties
+a not schema inverts the direction of the changes inside it, e.g. decreasing a maxLength inside not makes the schema accept more values, so the property checks don't walk it
+*/
+func UncheckedNotWarnCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			walkBodySchemaDiffs(operationItem, func(location bodySchemaLocation, schemaDiff *diff.SchemaDiff) {
+				if schemaDiff.NotDiff.Empty() {
+					return
+				}
+
+				id := location.id("not-modified")
+				result = append(result, BackwardCompatibilityError{
+					Id:          id,
+					Level:       config.getLogLevel(id, WARN),
+					Text:        fmt.Sprintf(config.i18n(id), location.args()...),
+					Comment:     config.i18n(notModifiedCommentId),
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      source,
+
+					PropertyPath:   location.propertyName,
+					BaseSource:     config.getBaseSource(baseSchema(schemaDiff), operationItem.Base),
+					RevisionSource: config.getRevisionSource(revisionSchema(schemaDiff), operationItem.Revision),
+				})
+			})
+		}
+	}
+	return result
+}
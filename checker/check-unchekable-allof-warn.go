@@ -40,6 +40,10 @@ func UncheckedRequestAllOfWarnCheck(diffReport *diff.Diff, operationsSources *di
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      source,
+
+								PropertyPath:   propertyFullName(propertyPath, propertyName),
+								BaseSource:     config.getBaseSource(baseSchema(parent), operationItem.Base),
+								RevisionSource: config.getRevisionSource(revisionSchema(parent), operationItem.Revision),
 							})
 						}
 					})
@@ -87,6 +91,10 @@ func UncheckedResponseAllOfWarnCheck(diffReport *diff.Diff, operationsSources *d
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      source,
+
+									PropertyPath:   propertyFullName(propertyPath, propertyName),
+									BaseSource:     config.getBaseSource(baseSchema(parent), responseDiff.Base, operationItem.Base),
+									RevisionSource: config.getRevisionSource(revisionSchema(parent), responseDiff.Revision, operationItem.Revision),
 								})
 							}
 						})
@@ -0,0 +1,20 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+// UniqueItemsUpdatedCheck checks arrays which became unique in requests, since clients may send duplicate items, or which are no longer unique in responses, since clients may rely on the items being unique
+func UniqueItemsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	return checkConstraintChanges(diffReport, operationsSources, config, func(request bool, schemaDiff *diff.SchemaDiff) []constraintChange {
+		uniqueItemsDiff := schemaDiff.UniqueItemsDiff
+
+		if request && uniqueItemsDiff.CompareWithDefault(false, true, false) {
+			return newConstraintChange("unique-items-set", ERR)
+		}
+		if !request && uniqueItemsDiff.CompareWithDefault(true, false, false) {
+			return newConstraintChange("unique-items-unset", ERR)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,42 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: setting uniqueItems of a request parameter is breaking
+func TestUniqueItemsParameterSet(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.UniqueItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		s.Spec.Paths["/items"].Post.Parameters.GetByInAndName("query", "ids").Schema.Value.UniqueItems = true
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withParameter(newError("request-parameter-unique-items-set", checker.ERR, "for the 'query' request parameter 'ids', the uniqueItems was set"), "ids"),
+	}, errs)
+}
+
+// BC: setting uniqueItems of a request property is breaking
+func TestUniqueItemsPropertySet(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.UniqueItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(_, s *load.SpecInfo) {
+		getItemSchema(s, "tags").UniqueItems = true
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withProperty(newError("request-property-unique-items-set", checker.ERR, "the uniqueItems was set in the request property 'tags'"), "tags"),
+	}, errs)
+}
+
+// BC: unsetting uniqueItems of a response header is breaking
+func TestUniqueItemsHeaderUnset(t *testing.T) {
+	newError := newOperationError("POST", "createItem", "/items", constraintsSpec)
+	errs := getChanges(t, singleCheckConfig(checker.UniqueItemsUpdatedCheck), constraintsSpec, constraintsSpec, func(s, _ *load.SpecInfo) {
+		s.Spec.Paths["/items"].Post.Responses["200"].Value.Headers["X-Tags"].Value.Schema.Value.UniqueItems = true
+	})
+	require.ElementsMatch(t, checker.BackwardCompatibilityErrors{
+		withHeader(newError("response-header-unique-items-unset", checker.ERR, "the response header 'X-Tags' uniqueItems was unset for the response status '200'"), "X-Tags"),
+	}, errs)
+}
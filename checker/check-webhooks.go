@@ -0,0 +1,90 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	webhookAddedCheckId            = "webhook-added"
+	webhookRemovedCheckId          = "webhook-removed"
+	webhookOperationAddedCheckId   = "webhook-operation-added"
+	webhookOperationRemovedCheckId = "webhook-operation-removed"
+	webhookRequestChangedId        = "webhook-request-changed"
+	webhookResponseChangedId       = "webhook-response-changed"
+	webhookRequestStatusId         = "webhook-request-status"
+	webhookSourcePrefix            = "webhooks."
+	webhookIdPrefix                = "webhook-"
+)
+
+// WebhooksCheck checks changes in the webhooks of OpenAPI 3.1 specs
+// like in callbacks, the API provider sends the webhook requests, so the direction is inverted:
+// - removing a webhook or a webhook operation breaks the subscribers which rely on it
+// - the webhook request body is checked like a response and for new required properties, and the webhook responses are checked like request bodies
+// the errors are reported with the webhook name as the path, and the ids of the errors found in the request and responses are prefixed by webhook-
+func WebhooksCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.WebhooksDiff == nil {
+		return result
+	}
+
+	newError := func(id string, defaultLevel Level, webhook string, operation string, source string, baseElement interface{}, revisionElement interface{}, args ...interface{}) BackwardCompatibilityError {
+		return BackwardCompatibilityError{
+			Id:        id,
+			Level:     config.getLogLevel(id, defaultLevel),
+			Text:      fmt.Sprintf(config.i18n(id), args...),
+			Operation: operation,
+			Path:      webhook,
+			Source:    source,
+
+			BaseSource:     config.getBaseSource(baseElement),
+			RevisionSource: config.getRevisionSource(revisionElement),
+		}
+	}
+
+	for _, webhook := range diffReport.WebhooksDiff.Added {
+		result = append(result, newError(webhookAddedCheckId, INFO, webhook, "N/A", webhookSourcePrefix+webhook, nil, diffReport.WebhooksDiff.Revision[webhook], ColorizedValue(webhook)))
+	}
+
+	for _, webhook := range diffReport.WebhooksDiff.Deleted {
+		result = append(result, newError(webhookRemovedCheckId, ERR, webhook, "N/A", webhookSourcePrefix+webhook, diffReport.WebhooksDiff.Base[webhook], nil, ColorizedValue(webhook)))
+	}
+
+	for webhook, webhookItem := range diffReport.WebhooksDiff.Modified {
+		if webhookItem.OperationsDiff == nil {
+			continue
+		}
+
+		for _, operation := range webhookItem.OperationsDiff.Added {
+			source := (*operationsSources)[webhookItem.Revision.GetOperation(operation)]
+			result = append(result, newError(webhookOperationAddedCheckId, INFO, webhook, operation, source, webhookItem.Base, webhookItem.Revision.GetOperation(operation), ColorizedValue(operation), ColorizedValue(webhook)))
+		}
+
+		for _, operation := range webhookItem.OperationsDiff.Deleted {
+			source := (*operationsSources)[webhookItem.Base.GetOperation(operation)]
+			result = append(result, newError(webhookOperationRemovedCheckId, ERR, webhook, operation, source, webhookItem.Base.GetOperation(operation), webhookItem.Revision, ColorizedValue(operation), ColorizedValue(webhook)))
+		}
+
+		for operation, operationItem := range webhookItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+			webhookEndpoint := ColorizedValue(operation + " " + webhook)
+			errs := checkInvertedOperation(config, webhookIdPrefix, operation, operationItem, config.i18n(webhookRequestStatusId),
+				func(text string) string {
+					return fmt.Sprintf(config.i18n(webhookRequestChangedId), webhookEndpoint, text)
+				},
+				func(responseStatus string, text string) string {
+					return fmt.Sprintf(config.i18n(webhookResponseChangedId), ColorizedValue(responseStatus), webhookEndpoint, text)
+				})
+			for _, err := range errs {
+				err.Operation = operation
+				err.OperationId = operationItem.Revision.OperationID
+				err.Path = webhook
+				err.Source = source
+				result = append(result, err)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,107 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const webhooksSpec = "../data/webhooks/spec_1.yaml"
+
+func petSchema(s *load.SpecInfo) *openapi3.Schema {
+	return s.Spec.Components.Schemas["Pet"].Value
+}
+
+// BC: removing a webhook is breaking
+func TestWebhooks_Removed(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		delete(s2.Webhooks, "deletedPet")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "webhook-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "N/A", errs[0].Operation)
+	require.Equal(t, "deletedPet", errs[0].Path)
+	require.Equal(t, "webhooks.deletedPet", errs[0].Source)
+	require.Equal(t, "removed the webhook 'deletedPet', subscribers will no longer receive it", errs[0].Text)
+}
+
+// BC: adding a webhook is not breaking
+func TestWebhooks_Added(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		delete(s1.Webhooks, "deletedPet")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "webhook-added", errs[0].Id)
+	require.Equal(t, checker.INFO, errs[0].Level)
+	require.Equal(t, "added the webhook 'deletedPet'", errs[0].Text)
+}
+
+// BC: removing an operation from a webhook is breaking
+func TestWebhooks_OperationRemoved(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		s1.Webhooks["newPet"].Put = s1.Webhooks["newPet"].Post
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "webhook-operation-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "PUT", errs[0].Operation)
+	require.Equal(t, "newPet", errs[0].Path)
+	require.Equal(t, "../data/webhooks/spec_1.yaml", errs[0].Source)
+	require.Equal(t, "removed the operation 'PUT' from the webhook 'newPet'", errs[0].Text)
+}
+
+// BC: removing a required property from a webhook request body is breaking
+func TestWebhooks_RequestRequiredPropertyRemoved(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		delete(petSchema(s2).Properties, "name")
+		petSchema(s2).Required = []string{"id"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "webhook-response-required-property-removed", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "POST", errs[0].Operation)
+	require.Equal(t, "newPet", errs[0].Path)
+	require.Equal(t, "../data/webhooks/spec_1.yaml", errs[0].Source)
+	require.Equal(t, "in the request of the webhook 'POST newPet', which is sent to subscribers: removed the required property 'name' from the response with the 'webhook request' status", errs[0].Text)
+}
+
+// BC: adding a required property to a webhook request body is breaking
+func TestWebhooks_RequestRequiredPropertyAdded(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		petSchema(s2).Properties["owner"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+		petSchema(s2).Required = []string{"id", "name", "owner"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "webhook-request-required-property-added", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "in the request of the webhook 'POST newPet', which is sent to subscribers: added the new required request property 'owner'", errs[0].Text)
+}
+
+// BC: making an existing property of a webhook request body required is not breaking
+func TestWebhooks_RequestPropertyBecameRequired(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		petSchema(s2).Required = []string{"id", "name", "tag"}
+	})
+	for _, err := range errs {
+		require.NotEqual(t, checker.ERR, err.Level, err.Text)
+	}
+}
+
+// BC: adding a required property to a webhook response is breaking
+func TestWebhooks_ResponseRequiredPropertyAdded(t *testing.T) {
+	errs := getChanges(t, checker.GetDefaultChecks(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		schema := s2.Webhooks["newPet"].Post.Responses["200"].Value.Content["application/json"].Schema.Value
+		schema.Properties["reason"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+		schema.Required = []string{"reason"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, "webhook-new-required-request-property", errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "POST", errs[0].Operation)
+	require.Equal(t, "newPet", errs[0].Path)
+	require.Equal(t, "in the response with the status '200' of the webhook 'POST newPet', which is sent by subscribers and checked like a request: added the new required request property 'reason'", errs[0].Text)
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/TwiN/go-color"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/checker/localizations"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
 )
 
 type Level int
@@ -19,8 +21,26 @@ const (
 	ERR  Level = 0
 	WARN Level = 1
 	INFO Level = 2
+	// NONE disables the reporting of a check when used in LogLevelOverrides
+	NONE Level = 3
 )
 
+// ParseLevel parses a level name: ERR, WARN, INFO or NONE (case-insensitive)
+func ParseLevel(level string) (Level, error) {
+	switch strings.ToUpper(level) {
+	case "ERR":
+		return ERR, nil
+	case "WARN":
+		return WARN, nil
+	case "INFO":
+		return INFO, nil
+	case "NONE":
+		return NONE, nil
+	default:
+		return INFO, fmt.Errorf("invalid level %q, must be one of ERR, WARN, INFO or NONE", level)
+	}
+}
+
 type BackwardCompatibilityError struct {
 	Id          string `json:"id,omitempty" yaml:"id,omitempty"`
 	Text        string `json:"text,omitempty" yaml:"text,omitempty"`
@@ -30,6 +50,14 @@ type BackwardCompatibilityError struct {
 	OperationId string `json:"operationId,omitempty" yaml:"operationId,omitempty"`
 	Path        string `json:"path,omitempty" yaml:"path,omitempty"`
 	Source      string `json:"source,omitempty" yaml:"source,omitempty"`
+
+	// the names of the elements which the change refers to, if any, they are matched by the ignore rules regardless of the language of the text
+	PropertyPath  string `json:"propertyPath,omitempty" yaml:"propertyPath,omitempty"` // the full name of a property, like 'data/name'
+	ParameterName string `json:"parameterName,omitempty" yaml:"parameterName,omitempty"`
+	HeaderName    string `json:"headerName,omitempty" yaml:"headerName,omitempty"`
+
+	BaseSource     *load.Location `json:"baseSource,omitempty" yaml:"baseSource,omitempty"`
+	RevisionSource *load.Location `json:"revisionSource,omitempty" yaml:"revisionSource,omitempty"`
 }
 
 type BackwardCompatibilityErrors []BackwardCompatibilityError
@@ -93,7 +121,7 @@ func (r *BackwardCompatibilityError) Error() string {
 	default:
 		levelName = "issue"
 	}
-	return fmt.Sprintf("%s at %s, in API %s %s %s [%s]. %s", levelName, r.Source, r.Operation, r.Path, r.Text, r.Id, r.Comment)
+	return fmt.Sprintf("%s at %s, in API %s %s %s [%s]. %s", levelName, r.SourceLocation(), r.Operation, r.Path, r.Text, r.Id, r.Comment)
 }
 
 func (r *BackwardCompatibilityError) LocalizedError(l localizations.Localizer) string {
@@ -108,7 +136,19 @@ func (r *BackwardCompatibilityError) LocalizedError(l localizations.Localizer) s
 	default:
 		levelName = "issue"
 	}
-	return fmt.Sprintf("%s %s %s, %s API %s %s %s [%s]. %s", levelName, l.Get("messages.at"), r.Source, l.Get("messages.in"), r.Operation, r.Path, r.Text, r.Id, r.Comment)
+	return fmt.Sprintf("%s %s %s, %s API %s %s %s [%s]. %s", levelName, l.Get("messages.at"), r.SourceLocation(), l.Get("messages.in"), r.Operation, r.Path, r.Text, r.Id, r.Comment)
+}
+
+// SourceLocation returns the location of the change as file:line:col, preferring the revision over the base
+// if the location is unknown, it returns the source spec
+func (r *BackwardCompatibilityError) SourceLocation() string {
+	if r.RevisionSource != nil {
+		return r.RevisionSource.String()
+	}
+	if r.BaseSource != nil {
+		return r.BaseSource.String()
+	}
+	return r.Source
 }
 
 var pipedOutput *bool
@@ -143,7 +183,13 @@ func (r *BackwardCompatibilityError) PrettyErrorText(l localizations.Localizer)
 	if r.Comment != "" {
 		comment = fmt.Sprintf("\n\t\t%s", r.Comment)
 	}
-	return fmt.Sprintf("%s\t[%s] %s %s\t\n\t%s API %s %s\n\t\t%s%s", levelName, color.InYellow(r.Id), l.Get("messages.at"), r.Source, l.Get("messages.in"), color.InGreen(r.Operation), color.InGreen(r.Path), r.Text, comment)
+	return fmt.Sprintf("%s\t[%s] %s %s\t\n\t%s API %s %s\n\t\t%s%s", levelName, color.InYellow(r.Id), l.Get("messages.at"), r.SourceLocation(), l.Get("messages.in"), color.InGreen(r.Operation), color.InGreen(r.Path), r.Text, comment)
+}
+
+// UncolorizedText returns the text of the error without the color codes added by ColorizedValue
+func (r *BackwardCompatibilityError) UncolorizedText() string {
+	result := strings.ReplaceAll(r.Text, color.Bold, "")
+	return strings.ReplaceAll(result, color.Reset, "")
 }
 
 type BackwardCompatibilityCheckConfig struct {
@@ -152,12 +198,29 @@ type BackwardCompatibilityCheckConfig struct {
 	MinSunsetStableDays int
 	Localizer           localizations.Localizer
 	LogLevelOverrides   map[string]Level
+	Locations           *diff.LocationsMap // optional, the locations of the spec elements which are used to set the sources of the errors
 }
 
 func (c *BackwardCompatibilityCheckConfig) i18n(messageID string) string {
 	return c.Localizer.Get("messages." + messageID)
 }
 
+// getBaseSource returns the location of the first base element which has one, elements are passed from the most specific to the most general
+func (c *BackwardCompatibilityCheckConfig) getBaseSource(elements ...interface{}) *load.Location {
+	if c.Locations == nil {
+		return nil
+	}
+	return c.Locations.Base.Find(elements...)
+}
+
+// getRevisionSource returns the location of the first revision element which has one, elements are passed from the most specific to the most general
+func (c *BackwardCompatibilityCheckConfig) getRevisionSource(elements ...interface{}) *load.Location {
+	if c.Locations == nil {
+		return nil
+	}
+	return c.Locations.Revision.Find(elements...)
+}
+
 func (c *BackwardCompatibilityCheckConfig) getLogLevel(checkerId string, defaultLevel Level) Level {
 	if level, ok := c.LogLevelOverrides[checkerId]; ok {
 		return level
@@ -176,7 +239,7 @@ func CheckBackwardCompatibilityUntilLevel(config BackwardCompatibilityCheckConfi
 		return result
 	}
 
-	result = removeDraftAndAlphaOperationsDiffs(diffReport, result, operationsSources)
+	result = removeDraftAndAlphaOperationsDiffs(config, diffReport, result, operationsSources)
 
 	for _, check := range config.Checks {
 		errs := check(diffReport, operationsSources, config)
@@ -185,7 +248,9 @@ func CheckBackwardCompatibilityUntilLevel(config BackwardCompatibilityCheckConfi
 
 	filteredResult := make(BackwardCompatibilityErrors, 0)
 	for _, change := range result {
-		if change.Level <= level {
+		// apply the overrides also to checks which report a fixed level
+		change.Level = config.getLogLevel(change.Id, change.Level)
+		if change.Level != NONE && change.Level <= level {
 			filteredResult = append(filteredResult, change)
 		}
 	}
@@ -194,7 +259,7 @@ func CheckBackwardCompatibilityUntilLevel(config BackwardCompatibilityCheckConfi
 	return filteredResult
 }
 
-func removeDraftAndAlphaOperationsDiffs(diffReport *diff.Diff, result []BackwardCompatibilityError, operationsSources *diff.OperationsSourcesMap) []BackwardCompatibilityError {
+func removeDraftAndAlphaOperationsDiffs(config BackwardCompatibilityCheckConfig, diffReport *diff.Diff, result []BackwardCompatibilityError, operationsSources *diff.OperationsSourcesMap) []BackwardCompatibilityError {
 	if diffReport.PathsDiff == nil {
 		return result
 	}
@@ -287,6 +352,9 @@ func removeDraftAndAlphaOperationsDiffs(diffReport *diff.Diff, result []Backward
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      source,
+
+					BaseSource:     config.getBaseSource(operationItem.Base),
+					RevisionSource: config.getRevisionSource(operationItem.Revision),
 				})
 				continue
 			}
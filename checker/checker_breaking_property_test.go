@@ -559,7 +559,7 @@ func TestBreaking_WriteOnlyPropertyRequiredDisabled(t *testing.T) {
 	require.Empty(t, errs)
 }
 
-// BC: changing an existing required property in response body to write-only is not breaking
+// BC: changing an existing required property in response body to write-only is breaking
 func TestBreaking_RequiredPropertyWriteOnlyEnabled(t *testing.T) {
 	s1, err := open(getReqPropFile("write-only-changed-base.yaml"))
 	require.NoError(t, err)
@@ -570,7 +570,11 @@ func TestBreaking_RequiredPropertyWriteOnlyEnabled(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
-	require.Empty(t, errs)
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		require.Equal(t, "response-required-property-became-write-only", err.Id)
+		require.Equal(t, checker.ERR, err.Level)
+	}
 }
 
 // BC: changing an existing required property in response body to not-write-only is breaking
@@ -106,3 +106,121 @@ func TestBreaking_ReqTypeNumberToInt32(t *testing.T) {
 	require.Equal(t, "request-body-type-changed", errs[0].Id)
 	require.Equal(t, "the request's body type/format changed from 'number'/'none' to 'integer'/'int32'", errs[0].Text)
 }
+
+// BC: changing request's body schema format from int64 to int32 is breaking
+func TestBreaking_ReqFormatNarrowed(t *testing.T) {
+	file := "../data/type-change/simple-request.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Type = "integer"
+	s1.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "int64"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Type = "integer"
+	s2.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "int32"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-body-type-changed", errs[0].Id)
+	require.Equal(t, "the request's body type/format changed from 'integer'/'int64' to 'integer'/'int32'", errs[0].Text)
+}
+
+// BC: changing request's body schema format from int32 to int64 is not breaking
+func TestBreaking_ReqFormatWidened(t *testing.T) {
+	file := "../data/type-change/simple-request.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Type = "integer"
+	s1.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "int32"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Type = "integer"
+	s2.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "int64"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Empty(t, errs)
+}
+
+// BC: changing request's body schema format from date-time to date is breaking
+func TestBreaking_ReqFormatIncompatible(t *testing.T) {
+	file := "../data/type-change/simple-request.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "date-time"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "date"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-body-type-changed", errs[0].Id)
+	require.Equal(t, "the request's body type/format changed from 'string'/'date-time' to 'string'/'date'", errs[0].Text)
+}
+
+// BC: changing request's body schema format from date to date-time is breaking
+func TestBreaking_ReqFormatDateToDateTime(t *testing.T) {
+	file := "../data/type-change/simple-request.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "date"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Format = "date-time"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-body-type-changed", errs[0].Id)
+	require.Equal(t, "the request's body type/format changed from 'string'/'date' to 'string'/'date-time'", errs[0].Text)
+}
+
+// BC: changing request parameter's schema format from date-time to date is breaking
+func TestBreaking_ReqParamFormatIncompatible(t *testing.T) {
+	file := "../data/type-change/simple-parameter.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Get.Parameters.GetByInAndName("query", "since").Schema.Value.Format = "date"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "request-parameter-type-changed", errs[0].Id)
+	require.Equal(t, "for the 'query' request parameter 'since', the type/format was changed from 'string'/'date-time' to 'string'/'date'", errs[0].Text)
+}
+
+// BC: removing the format of a request parameter's schema is not breaking
+func TestBreaking_ReqParamFormatRemoved(t *testing.T) {
+	file := "../data/type-change/simple-parameter.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Get.Parameters.GetByInAndName("query", "since").Schema.Value.Format = ""
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Empty(t, errs)
+}
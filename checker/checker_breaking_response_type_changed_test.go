@@ -120,3 +120,88 @@ func TestBreaking_RespTypeChanged(t *testing.T) {
 	require.Equal(t, "response-property-type-changed", errs[0].Id)
 	require.Equal(t, "the response's property type/format changed from 'string'/'none' to 'integer'/'int32' for status '200'", errs[0].Text)
 }
+
+// BC: changing response's body schema format from int32 to int64 is breaking
+func TestBreaking_RespFormatWidened(t *testing.T) {
+	file := "../data/type-change/simple-response.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "integer"
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "int32"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "integer"
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "int64"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-body-type-changed", errs[0].Id)
+	require.Equal(t, "the response's body type/format changed from 'integer'/'int32' to 'integer'/'int64' for status '200'", errs[0].Text)
+}
+
+// BC: changing response's body schema format from int64 to int32 is not breaking
+func TestBreaking_RespFormatNarrowed(t *testing.T) {
+	file := "../data/type-change/simple-response.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "integer"
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "int64"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "integer"
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "int32"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Empty(t, errs)
+}
+
+// BC: changing response's body schema format from date-time to date is breaking
+func TestBreaking_RespFormatIncompatible(t *testing.T) {
+	file := "../data/type-change/simple-response.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "string"
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "date-time"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "string"
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "date"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-body-type-changed", errs[0].Id)
+	require.Equal(t, "the response's body type/format changed from 'string'/'date-time' to 'string'/'date' for status '200'", errs[0].Text)
+}
+
+// BC: removing the format uuid from response's body schema is breaking
+func TestBreaking_RespFormatRemoved(t *testing.T) {
+	file := "../data/type-change/simple-response.yaml"
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "string"
+	s1.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Format = "uuid"
+
+	s2, err := open(file)
+	require.NoError(t, err)
+	s2.Spec.Paths["/test"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Type = "string"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "response-body-type-changed", errs[0].Id)
+	require.Equal(t, "the response's body type/format changed from 'string'/'uuid' to 'string'/'none' for status '200'", errs[0].Text)
+}
@@ -205,6 +205,8 @@ func TestBreaking_HeaderParamRequiredEnabled(t *testing.T) {
 			Operation: "GET",
 			Path:      "/api/{domain}/{project}/install-command",
 			Source:    "../data/openapi-test1.yaml",
+
+			ParameterName: "network-policies",
 		}}, errs)
 }
 
@@ -0,0 +1,68 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func openOpenAPI31(file string) (*load.SpecInfo, error) {
+	loader := openapi3.NewLoader()
+	loader.ReadFromURIFunc = load.ReadFromURI
+	return load.LoadSpecInfoFromFile(loader, file)
+}
+
+func getJSONSchemaKeywordsErrors(t *testing.T) checker.BackwardCompatibilityErrors {
+	t.Helper()
+
+	s1, err := openOpenAPI31("../data/openapi31/schema-keywords-base.yaml")
+	require.NoError(t, err)
+	s2, err := openOpenAPI31("../data/openapi31/schema-keywords-revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	return checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+}
+
+func getErrorTexts(errs checker.BackwardCompatibilityErrors, id string) []string {
+	result := []string{}
+	for _, err := range errs {
+		if err.Id == id {
+			result = append(result, err.Text)
+		}
+	}
+	return result
+}
+
+// BC: removing null from the type array of a request property is breaking
+func TestBreaking_TypeArrayNullRemoved(t *testing.T) {
+	require.Equal(t,
+		[]string{"the request property 'name' became not nullable"},
+		getErrorTexts(getJSONSchemaKeywordsErrors(t), "request-property-became-not-nullable"))
+}
+
+// BC: increasing a numeric exclusiveMinimum of a request property is breaking
+func TestBreaking_ExclusiveMinimumIncreased(t *testing.T) {
+	require.Equal(t,
+		[]string{"the 'age' request property's min was increased to '1.00'"},
+		getErrorTexts(getJSONSchemaKeywordsErrors(t), "request-property-min-increased"))
+}
+
+// BC: changing the type of a property in a prefixItems schema is breaking
+func TestBreaking_PrefixItemsPropertyTypeChanged(t *testing.T) {
+	require.Contains(t,
+		getErrorTexts(getJSONSchemaKeywordsErrors(t), "request-property-type-changed"),
+		"the 'position/prefixItems[#2]/value' request property type/format changed from 'number'/'none' to 'string'/'none'")
+}
+
+// BC: changing the type of a property in a then schema is breaking
+func TestBreaking_ThenPropertyTypeChanged(t *testing.T) {
+	require.Contains(t,
+		getErrorTexts(getJSONSchemaKeywordsErrors(t), "request-property-type-changed"),
+		"the '/then/license' request property type/format changed from 'string'/'none' to 'integer'/'none'")
+}
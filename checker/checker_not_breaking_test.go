@@ -54,49 +54,73 @@ func TestBreaking_RequestBodyRequiredDisabled(t *testing.T) {
 // BC: deleting a tag is not breaking
 func TestBreaking_DeletedTag(t *testing.T) {
 	r := d(t, getConfig(), 1, 5)
-	require.Len(t, r, 6)
-	require.Equal(t, "response-body-type-changed", r[0].Id)
-	require.Equal(t, "response-success-status-removed", r[1].Id)
-	require.Equal(t, "api-path-removed-without-deprecation", r[2].Id)
-	require.Equal(t, "api-path-removed-without-deprecation", r[3].Id)
-	require.Equal(t, "optional-response-header-removed", r[4].Id)
-	require.Equal(t, "request-parameter-removed", r[5].Id)
+	require.Len(t, r, 9)
+	require.Equal(t, "api-security-component-scheme-changed", r[0].Id)
+	require.Equal(t, "api-security-component-type-changed", r[1].Id)
+	require.Equal(t, "response-body-type-changed", r[2].Id)
+	require.Equal(t, "response-success-status-removed", r[3].Id)
+	require.Equal(t, "api-path-removed-without-deprecation", r[4].Id)
+	require.Equal(t, "api-path-removed-without-deprecation", r[5].Id)
+	require.Equal(t, "api-security-component-removed", r[6].Id)
+	require.Equal(t, "optional-response-header-removed", r[7].Id)
+	require.Equal(t, "request-parameter-removed", r[8].Id)
 }
 
 // BC: adding an enum value is not breaking
 func TestBreaking_AddedEnum(t *testing.T) {
 	r := d(t, getConfig(), 1, 3)
-	require.Len(t, r, 6)
+	require.Len(t, r, 13)
 	require.Equal(t, "response-success-status-removed", r[0].Id)
 	require.Equal(t, "response-success-status-removed", r[1].Id)
-	require.Equal(t, "request-parameter-removed", r[2].Id)
-	require.Equal(t, "request-parameter-removed", r[3].Id)
-	require.Equal(t, "request-parameter-removed", r[4].Id)
-	require.Equal(t, "request-parameter-removed", r[5].Id)
+	require.Equal(t, "api-security-removed", r[2].Id)
+	require.Equal(t, "api-security-scope-added", r[3].Id)
+	require.Equal(t, "callback-response-property-type-changed", r[4].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[5].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[6].Id)
+	require.Equal(t, "api-security-component-removed", r[7].Id)
+	require.Equal(t, "api-security-component-removed", r[8].Id)
+	require.Equal(t, "request-parameter-removed", r[9].Id)
+	require.Equal(t, "request-parameter-removed", r[10].Id)
+	require.Equal(t, "request-parameter-removed", r[11].Id)
+	require.Equal(t, "request-parameter-removed", r[12].Id)
 }
 
 // BC: changing extensions is not breaking
 func TestBreaking_ModifiedExtension(t *testing.T) {
 	r := d(t, getConfig(), 1, 3)
-	require.Len(t, r, 6)
+	require.Len(t, r, 13)
 	require.Equal(t, "response-success-status-removed", r[0].Id)
 	require.Equal(t, "response-success-status-removed", r[1].Id)
-	require.Equal(t, "request-parameter-removed", r[2].Id)
-	require.Equal(t, "request-parameter-removed", r[3].Id)
-	require.Equal(t, "request-parameter-removed", r[4].Id)
-	require.Equal(t, "request-parameter-removed", r[5].Id)
+	require.Equal(t, "api-security-removed", r[2].Id)
+	require.Equal(t, "api-security-scope-added", r[3].Id)
+	require.Equal(t, "callback-response-property-type-changed", r[4].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[5].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[6].Id)
+	require.Equal(t, "api-security-component-removed", r[7].Id)
+	require.Equal(t, "api-security-component-removed", r[8].Id)
+	require.Equal(t, "request-parameter-removed", r[9].Id)
+	require.Equal(t, "request-parameter-removed", r[10].Id)
+	require.Equal(t, "request-parameter-removed", r[11].Id)
+	require.Equal(t, "request-parameter-removed", r[12].Id)
 }
 
 // BC: changing comments is not breaking
 func TestBreaking_Comments(t *testing.T) {
 	r := d(t, getConfig(), 1, 3)
-	require.Len(t, r, 6)
+	require.Len(t, r, 13)
 	require.Equal(t, "response-success-status-removed", r[0].Id)
 	require.Equal(t, "response-success-status-removed", r[1].Id)
-	require.Equal(t, "request-parameter-removed", r[2].Id)
-	require.Equal(t, "request-parameter-removed", r[3].Id)
-	require.Equal(t, "request-parameter-removed", r[4].Id)
-	require.Equal(t, "request-parameter-removed", r[5].Id)
+	require.Equal(t, "api-security-removed", r[2].Id)
+	require.Equal(t, "api-security-scope-added", r[3].Id)
+	require.Equal(t, "callback-response-property-type-changed", r[4].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[5].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[6].Id)
+	require.Equal(t, "api-security-component-removed", r[7].Id)
+	require.Equal(t, "api-security-component-removed", r[8].Id)
+	require.Equal(t, "request-parameter-removed", r[9].Id)
+	require.Equal(t, "request-parameter-removed", r[10].Id)
+	require.Equal(t, "request-parameter-removed", r[11].Id)
+	require.Equal(t, "request-parameter-removed", r[12].Id)
 }
 
 // BC: new optional header param is not breaking
@@ -150,19 +174,35 @@ func TestBreaking_NewRequiredResponseHeader(t *testing.T) {
 // BC: changing operation ID is not breaking
 func TestBreaking_OperationID(t *testing.T) {
 	r := d(t, getConfig(), 3, 1)
-	require.Len(t, r, 3)
-	require.Equal(t, "request-parameter-max-length-decreased", r[0].Id)
-	require.Equal(t, "request-parameter-enum-value-removed", r[1].Id)
-	require.Equal(t, "request-parameter-pattern-added", r[2].Id)
+	require.Len(t, r, 11)
+	require.Equal(t, "api-global-security-became-required", r[0].Id)
+	require.Equal(t, "api-security-component-oauth-flow-removed", r[1].Id)
+	require.Equal(t, "request-parameter-max-length-decreased", r[2].Id)
+	require.Equal(t, "request-parameter-enum-value-removed", r[3].Id)
+	require.Equal(t, "callback-removed", r[4].Id)
+	require.Equal(t, "callback-path-removed", r[5].Id)
+	require.Equal(t, "callback-response-property-type-changed", r[6].Id)
+	require.Equal(t, "api-security-component-oauth-scope-removed", r[7].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[8].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[9].Id)
+	require.Equal(t, "request-parameter-pattern-added", r[10].Id)
 }
 
 // BC: changing a link to operation ID is not breaking
 func TestBreaking_LinkOperationID(t *testing.T) {
 	r := d(t, getConfig(), 3, 1)
-	require.Len(t, r, 3)
-	require.Equal(t, "request-parameter-max-length-decreased", r[0].Id)
-	require.Equal(t, "request-parameter-enum-value-removed", r[1].Id)
-	require.Equal(t, "request-parameter-pattern-added", r[2].Id)
+	require.Len(t, r, 11)
+	require.Equal(t, "api-global-security-became-required", r[0].Id)
+	require.Equal(t, "api-security-component-oauth-flow-removed", r[1].Id)
+	require.Equal(t, "request-parameter-max-length-decreased", r[2].Id)
+	require.Equal(t, "request-parameter-enum-value-removed", r[3].Id)
+	require.Equal(t, "callback-removed", r[4].Id)
+	require.Equal(t, "callback-path-removed", r[5].Id)
+	require.Equal(t, "callback-response-property-type-changed", r[6].Id)
+	require.Equal(t, "api-security-component-oauth-scope-removed", r[7].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[8].Id)
+	require.Equal(t, "api-security-component-oauth-url-changed", r[9].Id)
+	require.Equal(t, "request-parameter-pattern-added", r[10].Id)
 }
 
 // BC: adding a media-type to response is not breaking
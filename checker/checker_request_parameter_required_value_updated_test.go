@@ -30,6 +30,8 @@ func TestBreaking_HeaderParamBecameRequired(t *testing.T) {
 			Operation: "GET",
 			Path:      "/api/{domain}/{project}/install-command",
 			Source:    "../data/openapi-test1.yaml",
+
+			ParameterName: "network-policies",
 		}}, errs)
 }
 
@@ -54,5 +56,7 @@ func TestBreaking_HeaderParamBecameOptional(t *testing.T) {
 			Operation: "GET",
 			Path:      "/api/{domain}/{project}/install-command",
 			Source:    "../data/openapi-test1.yaml",
+
+			ParameterName: "network-policies",
 		}}, errs)
 }
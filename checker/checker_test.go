@@ -5,8 +5,71 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
 )
 
+// getChanges returns the changes of all levels found by the checks between two spec files, after they are modified by the optional modify function
+func getChanges(t *testing.T, config checker.BackwardCompatibilityCheckConfig, base, revision string, modify func(s1, s2 *load.SpecInfo)) checker.BackwardCompatibilityErrors {
+	t.Helper()
+	return getChangesWithDiffConfig(t, getConfig(), config, base, revision, modify)
+}
+
+// getChangesWithDiffConfig is like getChanges with a custom diff config
+func getChangesWithDiffConfig(t *testing.T, diffConfig *diff.Config, config checker.BackwardCompatibilityCheckConfig, base, revision string, modify func(s1, s2 *load.SpecInfo)) checker.BackwardCompatibilityErrors {
+	t.Helper()
+
+	s1, err := open(base)
+	require.NoError(t, err)
+	s2, err := open(revision)
+	require.NoError(t, err)
+	if modify != nil {
+		modify(s1, s2)
+	}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diffConfig, s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+	for _, err := range errs {
+		_, ok := checker.GetRule(err.Id)
+		require.True(t, ok, err.Id)
+	}
+	return errs
+}
+
+// newOperationError returns a function which builds the changes expected in an operation
+func newOperationError(operation, operationId, path, source string) func(id string, level checker.Level, text string) checker.BackwardCompatibilityError {
+	return func(id string, level checker.Level, text string) checker.BackwardCompatibilityError {
+		return checker.BackwardCompatibilityError{
+			Id:          id,
+			Level:       level,
+			Text:        text,
+			Operation:   operation,
+			OperationId: operationId,
+			Path:        path,
+			Source:      source,
+		}
+	}
+}
+
+// withProperty sets the path of the property which an expected change refers to
+func withProperty(err checker.BackwardCompatibilityError, propertyPath string) checker.BackwardCompatibilityError {
+	err.PropertyPath = propertyPath
+	return err
+}
+
+// withParameter sets the name of the parameter which an expected change refers to
+func withParameter(err checker.BackwardCompatibilityError, paramName string) checker.BackwardCompatibilityError {
+	err.ParameterName = paramName
+	return err
+}
+
+// withHeader sets the name of the response header which an expected change refers to
+func withHeader(err checker.BackwardCompatibilityError, headerName string) checker.BackwardCompatibilityError {
+	err.HeaderName = headerName
+	return err
+}
+
 func TestIsEmpty_EmptyIncludeWarns(t *testing.T) {
 	bcErrors := checker.BackwardCompatibilityErrors{}
 	require.True(t, bcErrors.IsEmpty(true))
@@ -44,3 +107,32 @@ func TestIsEmpty_OneWarnExcludeWarns(t *testing.T) {
 	}
 	require.True(t, bcErrors.IsEmpty(false))
 }
+
+func TestParseLevel(t *testing.T) {
+	level, err := checker.ParseLevel("warn")
+	require.NoError(t, err)
+	require.Equal(t, checker.WARN, level)
+
+	_, err = checker.ParseLevel("FATAL")
+	require.EqualError(t, err, "invalid level \"FATAL\", must be one of ERR, WARN, INFO or NONE")
+}
+
+func TestLogLevelOverrides(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
+	require.NoError(t, err)
+
+	config := checker.GetDefaultChecks()
+	config.LogLevelOverrides["request-parameter-removed"] = checker.ERR
+	config.LogLevelOverrides["response-success-status-removed"] = checker.NONE
+	errs := checker.CheckBackwardCompatibility(config, d, osm)
+	require.NotEmpty(t, errs)
+	for _, bcErr := range errs {
+		require.NotEqual(t, "response-success-status-removed", bcErr.Id)
+		if bcErr.Id == "request-parameter-removed" {
+			require.Equal(t, checker.ERR, bcErr.Level)
+		}
+	}
+}
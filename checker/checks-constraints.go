@@ -0,0 +1,193 @@
+package checker
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+// constraintLocation identifies a schema whose constraints are checked: a request parameter, a request or response body, a property within them, or a response header
+type constraintLocation struct {
+	bodySchemaLocation
+	paramLocation string
+	paramName     string
+	headerName    string
+}
+
+// id returns the check id of a change in this location, for example: request-parameter-max-items-decreased or response-header-max-items-increased
+func (location constraintLocation) id(change string) string {
+	switch {
+	case location.paramName != "":
+		return "request-parameter-" + change
+	case location.headerName != "":
+		return "response-header-" + change
+	default:
+		return location.bodySchemaLocation.id(change)
+	}
+}
+
+// args adds the parameter, header, property and response status to the values of a change, in the order which the messages expect them
+func (location constraintLocation) args(values ...interface{}) []interface{} {
+	switch {
+	case location.paramName != "":
+		return append([]interface{}{ColorizedValue(location.paramLocation), ColorizedValue(location.paramName)}, values...)
+	case location.headerName != "":
+		return append(append([]interface{}{ColorizedValue(location.headerName)}, values...), ColorizedValue(location.responseStatus))
+	default:
+		return location.bodySchemaLocation.args(values...)
+	}
+}
+
+// constraintChange is a change of a schema constraint, which is named like the suffix of its check id, for example: max-items-decreased
+type constraintChange struct {
+	name    string
+	level   Level
+	comment bool // the change has a localized comment explaining its level
+	values  []interface{}
+}
+
+func newConstraintChange(name string, level Level, values ...interface{}) []constraintChange {
+	result := constraintChange{name: name, level: level}
+	for _, value := range values {
+		result.values = append(result.values, ColorizedValue(value))
+	}
+	return []constraintChange{result}
+}
+
+/*
+checkConstraintChanges calls the processor with the schema diffs of the request parameters, request bodies, response bodies, their properties and the response headers
+the processor returns the changes of a schema diff according to the direction, and they are reported with the check id of the location
+*/
+func checkConstraintChanges(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config BackwardCompatibilityCheckConfig, processor func(request bool, schemaDiff *diff.SchemaDiff) []constraintChange) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			report := func(location constraintLocation, schemaDiff *diff.SchemaDiff) {
+				for _, change := range processor(location.request, schemaDiff) {
+					id := location.id(change.name)
+					err := BackwardCompatibilityError{
+						Id:          id,
+						Level:       config.getLogLevel(id, change.level),
+						Text:        fmt.Sprintf(config.i18n(id), location.args(change.values...)...),
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      source,
+
+						PropertyPath:   location.propertyName,
+						ParameterName:  location.paramName,
+						HeaderName:     location.headerName,
+						BaseSource:     config.getBaseSource(baseSchema(schemaDiff), operationItem.Base),
+						RevisionSource: config.getRevisionSource(revisionSchema(schemaDiff), operationItem.Revision),
+					}
+					if change.comment {
+						err.Comment = config.i18n(id + "-comment")
+					}
+					result = append(result, err)
+				}
+			}
+
+			if operationItem.ParametersDiff != nil {
+				for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+					for paramName, paramDiff := range paramDiffs {
+						if paramDiff.SchemaDiff == nil {
+							continue
+						}
+						report(constraintLocation{
+							bodySchemaLocation: bodySchemaLocation{request: true},
+							paramLocation:      paramLocation,
+							paramName:          paramName,
+						}, paramDiff.SchemaDiff)
+					}
+				}
+			}
+
+			walkBodySchemaDiffs(operationItem, func(location bodySchemaLocation, schemaDiff *diff.SchemaDiff) {
+				report(constraintLocation{bodySchemaLocation: location}, schemaDiff)
+			})
+
+			if operationItem.ResponsesDiff != nil {
+				for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+					if responseDiff == nil || responseDiff.HeadersDiff == nil {
+						continue
+					}
+					for headerName, headerDiff := range responseDiff.HeadersDiff.Modified {
+						if headerDiff.SchemaDiff == nil {
+							continue
+						}
+						report(constraintLocation{
+							bodySchemaLocation: bodySchemaLocation{responseStatus: responseStatus},
+							headerName:         headerName,
+						}, headerDiff.SchemaDiff)
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+/*
+getMaxChanges returns the changes of a max limit, like maxItems, which is unset when it is nil:
+- in requests, decreasing the limit is breaking and setting it is a warning, since clients may send values which exceed it
+- in responses, increasing or unsetting the limit is breaking, since clients may not be able to handle the values
+*/
+func getMaxChanges(keyword string, request bool, maxDiff *diff.ValueDiff) []constraintChange {
+	if maxDiff == nil {
+		return nil
+	}
+
+	if request {
+		switch {
+		case maxDiff.From == nil && maxDiff.To != nil:
+			changes := newConstraintChange(keyword+"-set", WARN, maxDiff.To)
+			changes[0].comment = true
+			return changes
+		case maxDiff.From != nil && maxDiff.To != nil && IsDecreasedValue(maxDiff):
+			return newConstraintChange(keyword+"-decreased", ERR, maxDiff.From, maxDiff.To)
+		}
+		return nil
+	}
+
+	switch {
+	case maxDiff.From != nil && maxDiff.To == nil:
+		return newConstraintChange(keyword+"-unset", ERR, maxDiff.From)
+	case maxDiff.From != nil && maxDiff.To != nil && IsIncreasedValue(maxDiff):
+		return newConstraintChange(keyword+"-increased", ERR, maxDiff.From, maxDiff.To)
+	}
+	return nil
+}
+
+// getMinChanges returns the changes of a min limit, like minProperties, which defaults to zero: increasing it is breaking in requests, and decreasing it is breaking in responses
+func getMinChanges(keyword string, request bool, minDiff *diff.ValueDiff) []constraintChange {
+	if minDiff == nil || minDiff.From == nil || minDiff.To == nil {
+		return nil
+	}
+
+	if request && IsIncreasedValue(minDiff) {
+		return newConstraintChange(keyword+"-increased", ERR, minDiff.From, minDiff.To)
+	}
+	if !request && IsDecreasedValue(minDiff) {
+		return newConstraintChange(keyword+"-decreased", ERR, minDiff.From, minDiff.To)
+	}
+	return nil
+}
+
+// isMultipleOf indicates whether a number is a whole multiple of another number, allowing for floating point errors
+func isMultipleOf(value, divisor float64) bool {
+	if divisor == 0 {
+		return false
+	}
+	quotient := value / divisor
+	return math.Abs(quotient-math.Round(quotient)) < 1e-9
+}
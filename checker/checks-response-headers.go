@@ -0,0 +1,87 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// responseHeaderSchemaDiff is the schema diff of a response header which was modified
+type responseHeaderSchemaDiff struct {
+	operation      string
+	operationId    string
+	path           string
+	source         string
+	responseStatus string
+	headerName     string
+	schemaDiff     *diff.SchemaDiff
+
+	// the base and revision elements which contain the schema, used to locate the errors
+	baseHeader        *openapi3.Header
+	revisionHeader    *openapi3.Header
+	baseOperation     *openapi3.Operation
+	revisionOperation *openapi3.Operation
+}
+
+// getResponseHeaderSchemaDiffs returns the schema diffs of the modified response headers of all modified operations
+func getResponseHeaderSchemaDiffs(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap) []responseHeaderSchemaDiff {
+	result := []responseHeaderSchemaDiff{}
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil || responseDiff.HeadersDiff == nil {
+					continue
+				}
+				for headerName, headerDiff := range responseDiff.HeadersDiff.Modified {
+					if headerDiff.SchemaDiff == nil ||
+						headerDiff.SchemaDiff.Base == nil || headerDiff.SchemaDiff.Base.Value == nil ||
+						headerDiff.SchemaDiff.Revision == nil || headerDiff.SchemaDiff.Revision.Value == nil {
+						continue
+					}
+					result = append(result, responseHeaderSchemaDiff{
+						operation:      operation,
+						operationId:    operationItem.Revision.OperationID,
+						path:           path,
+						source:         source,
+						responseStatus: responseStatus,
+						headerName:     headerName,
+						schemaDiff:     headerDiff.SchemaDiff,
+
+						baseHeader:        responseHeaderOf(responseDiff.Base, headerName),
+						revisionHeader:    responseHeaderOf(responseDiff.Revision, headerName),
+						baseOperation:     operationItem.Base,
+						revisionOperation: operationItem.Revision,
+					})
+				}
+			}
+		}
+	}
+	return result
+}
+
+func (headerDiff responseHeaderSchemaDiff) newError(config BackwardCompatibilityCheckConfig, id string, level Level, args ...interface{}) BackwardCompatibilityError {
+	return BackwardCompatibilityError{
+		Id:          id,
+		Level:       config.getLogLevel(id, level),
+		Text:        fmt.Sprintf(config.i18n(id), args...),
+		Operation:   headerDiff.operation,
+		OperationId: headerDiff.operationId,
+		Path:        headerDiff.path,
+		Source:      headerDiff.source,
+
+		HeaderName:     headerDiff.headerName,
+		BaseSource:     config.getBaseSource(baseSchema(headerDiff.schemaDiff), headerDiff.baseHeader, headerDiff.baseOperation),
+		RevisionSource: config.getRevisionSource(revisionSchema(headerDiff.schemaDiff), headerDiff.revisionHeader, headerDiff.revisionOperation),
+	}
+}
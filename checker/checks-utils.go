@@ -57,6 +57,11 @@ func CheckModifiedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor func(pro
 }
 
 func processModifiedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName string, propertyItem *diff.SchemaDiff, propertyParentItem *diff.SchemaDiff)) {
+	// a schema which was added or deleted, like new items, has no base or revision to compare
+	if schemaDiff.SchemaAdded || schemaDiff.SchemaDeleted {
+		return
+	}
+
 	if propertyName != "" || propertyPath != "" {
 		processor(propertyPath, propertyName, schemaDiff, parentDiff)
 	}
@@ -85,6 +90,16 @@ func processModifiedPropertiesDiff(propertyPath string, propertyName string, sch
 		processModifiedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, processor)
 	}
 
+	if schemaDiff.PrefixItemsDiff != nil {
+		for k, v := range schemaDiff.PrefixItemsDiff.Modified {
+			processModifiedPropertiesDiff(fmt.Sprintf("%s/prefixItems[%s]", propertyPath, k), "", v, schemaDiff, processor)
+		}
+	}
+
+	for _, subschema := range getSubschemaDiffs(schemaDiff) {
+		processModifiedPropertiesDiff(fmt.Sprintf("%s/%s", propertyPath, subschema.keyword), "", subschema.schemaDiff, schemaDiff, processor)
+	}
+
 	if schemaDiff.PropertiesDiff != nil {
 		for i, v := range schemaDiff.PropertiesDiff.Modified {
 			processModifiedPropertiesDiff(propertyPath, i, v, schemaDiff, processor)
@@ -92,6 +107,28 @@ func processModifiedPropertiesDiff(propertyPath string, propertyName string, sch
 	}
 }
 
+type keywordSchemaDiff struct {
+	keyword    string
+	schemaDiff *diff.SchemaDiff
+}
+
+// getSubschemaDiffs returns the diffs of the subschemas which are walked in addition to properties, items and the schema lists: additionalProperties and the JSON Schema 2020-12 if, then and else
+// not isn't walked since it inverts the direction of every check, its changes are reported by UncheckedNotWarnCheck
+func getSubschemaDiffs(schemaDiff *diff.SchemaDiff) []keywordSchemaDiff {
+	result := []keywordSchemaDiff{}
+	for _, subschema := range []keywordSchemaDiff{
+		{"additionalProperties", schemaDiff.AdditionalPropertiesDiff},
+		{"if", schemaDiff.IfDiff},
+		{"then", schemaDiff.ThenDiff},
+		{"else", schemaDiff.ElseDiff},
+	} {
+		if subschema.schemaDiff != nil {
+			result = append(result, subschema)
+		}
+	}
+	return result
+}
+
 func CheckAddedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, propertyParentDiff *diff.SchemaDiff)) {
 	if schemaDiff == nil {
 		return
@@ -124,6 +161,16 @@ func processAddedPropertiesDiff(propertyPath string, propertyName string, schema
 		processAddedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, processor)
 	}
 
+	if schemaDiff.PrefixItemsDiff != nil {
+		for k, v := range schemaDiff.PrefixItemsDiff.Modified {
+			processAddedPropertiesDiff(fmt.Sprintf("%s/prefixItems[%s]", propertyPath, k), "", v, schemaDiff, processor)
+		}
+	}
+
+	for _, subschema := range getSubschemaDiffs(schemaDiff) {
+		processAddedPropertiesDiff(fmt.Sprintf("%s/%s", propertyPath, subschema.keyword), "", subschema.schemaDiff, schemaDiff, processor)
+	}
+
 	if schemaDiff.PropertiesDiff != nil {
 		for _, v := range schemaDiff.PropertiesDiff.Added {
 			processor(propertyPath, v, schemaDiff.Revision.Value.Properties[v].Value, schemaDiff)
@@ -166,6 +213,16 @@ func processDeletedPropertiesDiff(propertyPath string, propertyName string, sche
 		processDeletedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, processor)
 	}
 
+	if schemaDiff.PrefixItemsDiff != nil {
+		for k, v := range schemaDiff.PrefixItemsDiff.Modified {
+			processDeletedPropertiesDiff(fmt.Sprintf("%s/prefixItems[%s]", propertyPath, k), "", v, schemaDiff, processor)
+		}
+	}
+
+	for _, subschema := range getSubschemaDiffs(schemaDiff) {
+		processDeletedPropertiesDiff(fmt.Sprintf("%s/%s", propertyPath, subschema.keyword), "", subschema.schemaDiff, schemaDiff, processor)
+	}
+
 	if schemaDiff.PropertiesDiff != nil {
 		for _, v := range schemaDiff.PropertiesDiff.Deleted {
 			processor(propertyPath, v, schemaDiff.Base.Value.Properties[v].Value, schemaDiff)
@@ -176,6 +233,78 @@ func processDeletedPropertiesDiff(propertyPath string, propertyName string, sche
 	}
 }
 
+// requestBodyOf returns the request body of an operation, or nil if there is none
+func requestBodyOf(operation *openapi3.Operation) *openapi3.RequestBody {
+	if operation == nil || operation.RequestBody == nil {
+		return nil
+	}
+	return operation.RequestBody.Value
+}
+
+// schemaOf returns the schema which a schema ref refers to, or nil if there is none
+func schemaOf(schemaRef *openapi3.SchemaRef) *openapi3.Schema {
+	if schemaRef == nil {
+		return nil
+	}
+	return schemaRef.Value
+}
+
+// requestMediaTypeOf returns a media type of the request body of an operation, or nil if there is none
+func requestMediaTypeOf(operation *openapi3.Operation, mediaType string) *openapi3.MediaType {
+	if requestBody := requestBodyOf(operation); requestBody != nil {
+		return requestBody.Content.Get(mediaType)
+	}
+	return nil
+}
+
+// parameterOf returns a parameter of an operation by its location and name, or nil if there is none
+func parameterOf(operation *openapi3.Operation, in string, name string) *openapi3.Parameter {
+	if operation == nil {
+		return nil
+	}
+	return operation.Parameters.GetByInAndName(in, name)
+}
+
+// responseOf returns a response of an operation by its status, or nil if there is none
+func responseOf(operation *openapi3.Operation, status string) *openapi3.Response {
+	if operation == nil || operation.Responses[status] == nil {
+		return nil
+	}
+	return operation.Responses[status].Value
+}
+
+// responseHeaderOf returns a header of a response by its name, or nil if there is none
+func responseHeaderOf(response *openapi3.Response, name string) *openapi3.Header {
+	if response == nil || response.Headers[name] == nil {
+		return nil
+	}
+	return response.Headers[name].Value
+}
+
+// responseMediaTypeOf returns a media type of a response, or nil if there is none
+func responseMediaTypeOf(response *openapi3.Response, mediaType string) *openapi3.MediaType {
+	if response == nil {
+		return nil
+	}
+	return response.Content.Get(mediaType)
+}
+
+// baseSchema returns the base schema of a schema diff, or nil if there is none
+func baseSchema(schemaDiff *diff.SchemaDiff) *openapi3.Schema {
+	if schemaDiff == nil {
+		return nil
+	}
+	return schemaOf(schemaDiff.Base)
+}
+
+// revisionSchema returns the revision schema of a schema diff, or nil if there is none
+func revisionSchema(schemaDiff *diff.SchemaDiff) *openapi3.Schema {
+	if schemaDiff == nil {
+		return nil
+	}
+	return schemaOf(schemaDiff.Revision)
+}
+
 func IsIncreased(from interface{}, to interface{}) bool {
 	fromUint64, ok := from.(uint64)
 	toUint64, okTo := to.(uint64)
@@ -218,3 +347,33 @@ func empty2none(a interface{}) interface{} {
 	}
 	return ColorizedValue(a)
 }
+
+// runOperationChecks runs the given checks, usually operationChecks, on a diff which contains only the given operation at the given path
+func runOperationChecks(config BackwardCompatibilityCheckConfig, checks []BackwardCompatibilityCheck, path string, operation string, methodDiff *diff.MethodDiff) []BackwardCompatibilityError {
+	basePathItem := &openapi3.PathItem{}
+	basePathItem.SetOperation(operation, methodDiff.Base)
+	revisionPathItem := &openapi3.PathItem{}
+	revisionPathItem.SetOperation(operation, methodDiff.Revision)
+
+	operationDiff := &diff.Diff{
+		PathsDiff: &diff.PathsDiff{
+			Modified: diff.ModifiedPaths{
+				path: &diff.PathDiff{
+					OperationsDiff: &diff.OperationsDiff{
+						Modified: diff.ModifiedOperations{
+							operation: methodDiff,
+						},
+					},
+					Base:     basePathItem,
+					Revision: revisionPathItem,
+				},
+			},
+		},
+	}
+
+	result := []BackwardCompatibilityError{}
+	for _, check := range checks {
+		result = append(result, check(operationDiff, &diff.OperationsSourcesMap{}, config)...)
+	}
+	return result
+}
@@ -1,6 +1,8 @@
 package checker
 
 import (
+	"reflect"
+
 	"github.com/tufin/oasdiff/checker/localizations"
 	"github.com/tufin/oasdiff/utils"
 )
@@ -62,6 +64,7 @@ func ValidateIncludeChecks(includeChecks utils.StringList) utils.StringList {
 func defaultChecks() []BackwardCompatibilityCheck {
 	return []BackwardCompatibilityCheck{
 		RequestParameterRemovedCheck,
+		RequestParameterRenamedCheck,
 		NewRequiredRequestPropertyCheck,
 		RequestParameterPatternAddedOrChangedCheck,
 		RequestPropertyPatternAddedOrChangedCheck,
@@ -79,21 +82,31 @@ func defaultChecks() []BackwardCompatibilityCheck {
 		RequestBodyBecameEnumCheck,
 		ResponseHeaderBecameOptional,
 		ResponseHeaderRemoved,
+		ResponseHeaderTypeChangedCheck,
+		ResponseHeaderEnumValueUpdatedCheck,
+		ResponseHeaderMinMaxUpdatedCheck,
+		ResponseHeaderPatternUpdatedCheck,
 		ResponseSuccessStatusRemoved,
-		ResponseMediaTypeRemoved,
+		ResponseMediaTypeUpdatedCheck,
+		RequestBodyMediaTypeUpdatedCheck,
+		MediaTypeSchemaChangedPartiallyCheck,
 		NewRequestPathParameterCheck,
 		NewRequestNonPathParameterCheck,
 		NewRequiredRequestHeaderPropertyCheck,
 		ResponseRequiredPropertyRemovedCheck,
 		UncheckedRequestAllOfWarnCheck,
 		UncheckedResponseAllOfWarnCheck,
+		UncheckedNotWarnCheck,
 		RequestPropertyRemovedCheck,
 		ResponseRequiredPropertyBecameNonWriteOnlyCheck,
+		ResponsePropertyWriteOnlyUpdatedCheck,
+		RequestPropertyReadOnlyUpdatedCheck,
 		RequestPropertyMaxLengthSetCheck,
 		RequestParameterMaxLengthSetCheck,
 		ResponsePropertyMaxLengthUnsetCheck,
 		RequestParameterMaxLengthDecreasedCheck,
 		RequestPropertyMaxLengthDecreasedCheck,
+		RequestPropertyAdditionalPropertiesDisallowedCheck,
 		ResponsePropertyMaxLengthIncreasedCheck,
 		ResponsePropertyMinLengthDecreasedCheck,
 		RequestPropertyMaxSetCheck,
@@ -110,12 +123,18 @@ func defaultChecks() []BackwardCompatibilityCheck {
 		RequestPropertyMinItemsIncreasedCheck,
 		ResponsePropertyMinItemsUnsetCheck,
 		ResponsePropertyMinItemsDecreasedCheck,
+		MaxItemsUpdatedCheck,
+		MinMaxPropertiesUpdatedCheck,
+		MultipleOfUpdatedCheck,
+		UniqueItemsUpdatedCheck,
+		ExclusiveBoundsUpdatedCheck,
 		RequestParameterEnumValueRemovedCheck,
 		RequestPropertyEnumValueRemovedCheck,
 		ResponsePropertyEnumValueAddedCheck,
 		RequestParameterXExtensibleEnumValueRemovedCheck,
 		RequestPropertyXExtensibleEnumValueRemovedCheck,
 		RequestParameterTypeChangedCheck,
+		RequestParameterSerializationUpdatedCheck,
 		RequestPropertyTypeChangedCheck,
 		ResponsePropertyTypeChangedCheck,
 		APIAddedCheck,
@@ -125,7 +144,79 @@ func defaultChecks() []BackwardCompatibilityCheck {
 		ResponsePropertyMaxIncreasedCheck,
 		ResponsePropertyMinDecreasedCheck,
 		RequestParameterDefaultValueChanged,
+		RequestPropertyDefaultValueChangedCheck,
+		APISecurityUpdatedCheck,
+		APIGlobalSecurityUpdatedCheck,
+		APISecurityComponentUpdatedCheck,
+		CallbacksCheck,
+		WebhooksCheck,
+		APIOperationEndpointChangedCheck,
+		PolymorphicSchemaUpdatedCheck,
+		DiscriminatorUpdatedCheck,
+	}
+}
+
+/*
+operationChecks returns the checks of the request, the responses and the parameters of a single operation
+they are run by the checks which check a part of an operation like an operation of its own, such as renamed parameters, operations whose endpoint changed, callbacks and webhooks, see runOperationChecks
+they are all the checks, including the optional ones, except for nonOperationChecks
+*/
+func operationChecks() []BackwardCompatibilityCheck {
+	excluded := map[uintptr]struct{}{}
+	for _, check := range nonOperationChecks() {
+		excluded[getCheckPointer(check)] = struct{}{}
+	}
+
+	result := []BackwardCompatibilityCheck{}
+	for _, check := range append(defaultChecks(), getOptionalChecks()...) {
+		if _, ok := excluded[getCheckPointer(check)]; !ok {
+			result = append(result, check)
+		}
+	}
+	return result
+}
+
+/*
+nonOperationChecks returns the checks which aren't run on a single operation by runOperationChecks:
+- the checks which run other checks, so that they don't recurse
+- the checks of the endpoints, the security, the callbacks and the components, so that they don't report changes twice
+*/
+func nonOperationChecks() []BackwardCompatibilityCheck {
+	return []BackwardCompatibilityCheck{
+		RequestParameterRenamedCheck,
+		APIOperationEndpointChangedCheck,
+		CallbacksCheck,
+		WebhooksCheck,
+		APIAddedCheck,
+		APIRemovedCheck,
+		APIDeprecationCheck,
+		APISunsetChangedCheck,
+		APISecurityUpdatedCheck,
+		APIGlobalSecurityUpdatedCheck,
+		APISecurityComponentUpdatedCheck,
+		APIOperationIdRemovedCheck,
+		APITagRemovedCheck,
+		APIComponentsSchemaRemovedCheck,
+	}
+}
+
+// getOptionalChecks returns the optional checks ordered by their ids
+func getOptionalChecks() []BackwardCompatibilityCheck {
+	ids := utils.StringList{}
+	for id := range optionalChecks {
+		ids = append(ids, id)
+	}
+
+	result := []BackwardCompatibilityCheck{}
+	for _, id := range ids.Sort() {
+		result = append(result, optionalChecks[id])
 	}
+	return result
+}
+
+// getCheckPointer identifies a check, since functions can't be compared
+func getCheckPointer(check BackwardCompatibilityCheck) uintptr {
+	return reflect.ValueOf(check).Pointer()
 }
 
 func allChecks() []BackwardCompatibilityCheck {
@@ -0,0 +1,87 @@
+package checker
+
+// formatChange classifies a change of the format of a schema whose type didn't change
+type formatChange int
+
+const (
+	formatUnchanged formatChange = iota
+	// formatWidened means that every value of the old format is also a valid value of the new format, for example: int32 to int64
+	formatWidened
+	// formatNarrowed means that every value of the new format is also a valid value of the old format, for example: int64 to int32
+	formatNarrowed
+	// formatIncompatible means that neither format contains the other, for example: date-time to date
+	formatIncompatible
+)
+
+/*
+formatSubsets is the format-compatibility matrix: it maps each type to the pairs of formats in which the first format is a subset of the second
+a schema without a format, represented by the empty string, contains all the formats of its type
+formats which don't appear in the matrix, like date and date-time, or custom formats, are incompatible with each other
+*/
+var formatSubsets = map[string]map[string][]string{
+	"integer": {
+		"int32": {"int64", "bigint"},
+		"int64": {"bigint"},
+	},
+	"number": {
+		"float": {"double"},
+		"int32": {"int64", "double"},
+	},
+	"string": {
+		"email":         {"idn-email"},
+		"hostname":      {"idn-hostname"},
+		"uri":           {"uri-reference", "iri", "iri-reference"},
+		"uri-reference": {"iri-reference"},
+		"iri":           {"iri-reference"},
+	},
+}
+
+// isFormatSubset indicates whether every value of format1 is a valid value of format2
+func isFormatSubset(schemaType string, format1, format2 string) bool {
+	if format2 == "" {
+		return true
+	}
+	for _, format := range formatSubsets[schemaType][format1] {
+		if format == format2 {
+			return true
+		}
+	}
+	return false
+}
+
+// getFormatChange classifies the change of the format of a schema, see formatSubsets
+func getFormatChange(schemaType string, from, to interface{}) formatChange {
+	fromFormat, _ := from.(string)
+	toFormat, _ := to.(string)
+
+	switch {
+	case fromFormat == toFormat:
+		return formatUnchanged
+	case isFormatSubset(schemaType, fromFormat, toFormat):
+		return formatWidened
+	case isFormatSubset(schemaType, toFormat, fromFormat):
+		return formatNarrowed
+	default:
+		return formatIncompatible
+	}
+}
+
+// isFormatChangeBreakingInRequest indicates whether a request will reject some of the values which clients could send before the change
+func isFormatChangeBreakingInRequest(schemaType string, from, to interface{}) bool {
+	switch getFormatChange(schemaType, from, to) {
+	case formatNarrowed, formatIncompatible:
+		return true
+	default:
+		return false
+	}
+}
+
+// isFormatChangeBreakingInResponse indicates whether a response may contain values which clients couldn't receive before the change
+func isFormatChangeBreakingInResponse(schemaType string, from, to interface{}) bool {
+	switch getFormatChange(schemaType, from, to) {
+	case formatWidened, formatIncompatible:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,149 @@
+package checker
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IgnoreDateFormat is the format of the expiry dates in structured ignore files
+const IgnoreDateFormat = "2006-01-02"
+
+// IgnoreRule is an entry of a structured ignore file
+// unlike the lines of a text ignore file, it is matched by check id rather than by the localized text of the error
+type IgnoreRule struct {
+	Id      string `json:"id" yaml:"id"`
+	Method  string `json:"method,omitempty" yaml:"method,omitempty"`
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`       // name of a property, a parameter or a header which the error refers to, nested properties are matched by their last path segments
+	Expires string `json:"expires,omitempty" yaml:"expires,omitempty"` // the rule stops ignoring errors after this date (YYYY-MM-DD)
+	Reason  string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+type IgnoreRules []IgnoreRule
+
+// String returns a short description of the rule for reports
+func (rule IgnoreRule) String() string {
+	result := rule.Id
+	if rule.Method != "" {
+		result += " " + strings.ToUpper(rule.Method)
+	}
+	if rule.Path != "" {
+		result += " " + rule.Path
+	}
+	if rule.Name != "" {
+		result += " " + rule.Name
+	}
+	return result
+}
+
+// LoadIgnoreRules loads a structured ignore file in YAML or JSON format
+func LoadIgnoreRules(ignoreFile string) (IgnoreRules, error) {
+	data, err := os.ReadFile(ignoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseIgnoreRules(data)
+}
+
+// ParseIgnoreRules parses and validates the contents of a structured ignore file
+func ParseIgnoreRules(data []byte) (IgnoreRules, error) {
+	var rules IgnoreRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	for i, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i+1, err)
+		}
+	}
+
+	return rules, nil
+}
+
+func (rule IgnoreRule) validate() error {
+	if rule.Id == "" {
+		return fmt.Errorf("missing check id")
+	}
+	if _, ok := GetRule(rule.Id); !ok {
+		return fmt.Errorf("unknown check id %q", rule.Id)
+	}
+	if rule.Expires != "" {
+		if _, err := time.Parse(IgnoreDateFormat, rule.Expires); err != nil {
+			return fmt.Errorf("invalid expiry date %q, must be in the format YYYY-MM-DD", rule.Expires)
+		}
+	}
+	return nil
+}
+
+// IsExpired indicates whether the rule has expired by the given time
+func (rule IgnoreRule) IsExpired(now time.Time) bool {
+	if rule.Expires == "" {
+		return false
+	}
+	expires, err := time.Parse(IgnoreDateFormat, rule.Expires)
+	if err != nil {
+		return false
+	}
+	// the rule is still valid on the day of expiry
+	return now.After(expires.AddDate(0, 0, 1))
+}
+
+func (rule IgnoreRule) matches(err BackwardCompatibilityError) bool {
+	if rule.Id != err.Id {
+		return false
+	}
+	if rule.Method != "" && !strings.EqualFold(rule.Method, err.Operation) {
+		return false
+	}
+	if rule.Path != "" && !strings.EqualFold(rule.Path, err.Path) {
+		return false
+	}
+	if rule.Name != "" && !rule.matchesName(err) {
+		return false
+	}
+	return true
+}
+
+// matchesName indicates whether the rule names the parameter or the header of the error, or its property or the last segments of its property path, like 'name' in 'data/name'
+func (rule IgnoreRule) matchesName(err BackwardCompatibilityError) bool {
+	return rule.Name == err.ParameterName ||
+		rule.Name == err.HeaderName ||
+		rule.Name == err.PropertyPath ||
+		strings.HasSuffix(err.PropertyPath, "/"+rule.Name)
+}
+
+// Apply removes the errors which match any of the rules that haven't expired by the given time
+// it also returns the rules which didn't match any error, including the expired ones, so they can be cleaned up
+func (rules IgnoreRules) Apply(errs []BackwardCompatibilityError, now time.Time) ([]BackwardCompatibilityError, IgnoreRules) {
+	result := make([]BackwardCompatibilityError, 0)
+
+	matchedRules := make([]bool, len(rules))
+	for _, err := range errs {
+		ignored := false
+		for ruleIndex, rule := range rules {
+			if rule.IsExpired(now) || !rule.matches(err) {
+				continue
+			}
+			matchedRules[ruleIndex] = true
+			ignored = true
+		}
+		if !ignored {
+			result = append(result, err)
+		}
+	}
+
+	stale := IgnoreRules{}
+	for ruleIndex, rule := range rules {
+		if !matchedRules[ruleIndex] {
+			stale = append(stale, rule)
+		}
+	}
+
+	return result, stale
+}
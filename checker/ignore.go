@@ -4,8 +4,6 @@ import (
 	"bufio"
 	"os"
 	"strings"
-
-	"github.com/TwiN/go-color"
 )
 
 func ignoreLinePath(ignoreLine string) string {
@@ -27,18 +25,32 @@ func ignoreLinePath(ignoreLine string) string {
 }
 
 func ProcessIgnoredBackwardCompatibilityErrors(level Level, errs []BackwardCompatibilityError, ignoreFile string) ([]BackwardCompatibilityError, error) {
-	result := make([]BackwardCompatibilityError, 0)
-
 	ignore, err := os.Open(ignoreFile)
 	if err != nil {
 		return nil, err
 	}
 	defer ignore.Close()
+
+	ignoreLines := []string{}
 	ignoreScanner := bufio.NewScanner(ignore)
+	for ignoreScanner.Scan() {
+		ignoreLines = append(ignoreLines, ignoreScanner.Text())
+	}
+	if err := ignoreScanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return IgnoreBackwardCompatibilityErrors(level, errs, ignoreLines), nil
+}
+
+// IgnoreBackwardCompatibilityErrors removes the errors of the given level which match any of the ignore lines
+// ignore lines have the same format as the lines of an ignore file
+func IgnoreBackwardCompatibilityErrors(level Level, errs []BackwardCompatibilityError, ignoreLines []string) []BackwardCompatibilityError {
+	result := make([]BackwardCompatibilityError, 0)
 
 	ignoredErrs := make([]bool, len(errs))
-	for ignoreScanner.Scan() {
-		ignoreLine := strings.ToLower(ignoreScanner.Text())
+	for _, ignoreLine := range ignoreLines {
+		ignoreLine = strings.ToLower(ignoreLine)
 
 		ignorePath := ignoreLinePath(ignoreLine)
 		if ignorePath == "" {
@@ -50,8 +62,7 @@ func ProcessIgnoredBackwardCompatibilityErrors(level Level, errs []BackwardCompa
 				continue
 			}
 
-			uncolorizedText := strings.ReplaceAll(err.Text, color.Bold, "")
-			uncolorizedText = strings.ReplaceAll(uncolorizedText, color.Reset, "")
+			uncolorizedText := err.UncolorizedText()
 
 			if ignorePath == strings.ToLower(err.Path) &&
 				strings.Contains(ignoreLine, strings.ToLower(err.Operation+" "+err.Path)) &&
@@ -66,5 +77,5 @@ func ProcessIgnoredBackwardCompatibilityErrors(level Level, errs []BackwardCompa
 			result = append(result, err)
 		}
 	}
-	return result, nil
+	return result
 }
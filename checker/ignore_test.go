@@ -2,6 +2,7 @@ package checker_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
@@ -15,11 +16,11 @@ func TestIgnore(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
-	require.Equal(t, 6, len(errs))
+	require.Equal(t, 13, len(errs))
 
 	errs, err = checker.ProcessIgnoredBackwardCompatibilityErrors(checker.ERR, errs, "../data/ignore-err-example.txt")
 	require.NoError(t, err)
-	require.Equal(t, 5, len(errs))
+	require.Equal(t, 12, len(errs))
 }
 
 func TestIgnoreSubpath(t *testing.T) {
@@ -50,3 +51,99 @@ func TestIgnoreOnlyIncludedSubpaths(t *testing.T) {
 	require.Equal(t, 1, len(errs))
 	require.Contains(t, errs[0].Path, "/resource/new") //see that new breaking change was kept even though it is a substring of newest
 }
+
+func TestIgnoreRules(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Equal(t, 13, len(errs))
+
+	rules, err := checker.LoadIgnoreRules("../data/ignore-rules.yaml")
+	require.NoError(t, err)
+	require.Len(t, rules, 5)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	errs, stale := rules.Apply(errs, now)
+	// two success statuses and two parameters are ignored
+	require.Equal(t, 9, len(errs))
+	require.Equal(t, checker.IgnoreRules{rules[2], rules[4]}, stale)
+	require.True(t, rules[2].IsExpired(now))
+	require.False(t, rules[1].IsExpired(now))
+}
+
+func TestIgnoreRulesBeforeExpiry(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+
+	rules, err := checker.LoadIgnoreRules("../data/ignore-rules.yaml")
+	require.NoError(t, err)
+
+	// the expiry date is inclusive
+	errs, stale := rules.Apply(errs, time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.Equal(t, 8, len(errs))
+	require.Equal(t, checker.IgnoreRules{rules[4]}, stale)
+}
+
+func TestIgnoreRulesJSON(t *testing.T) {
+	rules, err := checker.LoadIgnoreRules("../data/ignore-rules.json")
+	require.NoError(t, err)
+	require.Equal(t, checker.IgnoreRules{{
+		Id:     "response-success-status-removed",
+		Method: "GET",
+		Path:   "/api/{domain}/{project}/badges/security-score",
+		Reason: "the security score badge is served by the new badges service",
+	}}, rules)
+}
+
+func TestIgnoreRulesUnknownCheck(t *testing.T) {
+	_, err := checker.LoadIgnoreRules("../data/ignore-rules-unknown-check.yaml")
+	require.EqualError(t, err, "entry 1: unknown check id \"no-such-check\"")
+}
+
+func TestIgnoreRulesInvalid(t *testing.T) {
+	_, err := checker.ParseIgnoreRules([]byte("- method: GET"))
+	require.EqualError(t, err, "entry 1: missing check id")
+
+	_, err = checker.ParseIgnoreRules([]byte("- id: api-tag-removed\n  expires: next week"))
+	require.EqualError(t, err, "entry 1: invalid expiry date \"next week\", must be in the format YYYY-MM-DD")
+}
+
+func TestIgnoreRulesNestedProperty(t *testing.T) {
+	rules, err := checker.ParseIgnoreRules([]byte("- id: new-required-request-property\n  name: name"))
+	require.NoError(t, err)
+
+	newError := newOperationError("POST", "createUser", "/users", "")
+	errs, stale := rules.Apply([]checker.BackwardCompatibilityError{
+		withProperty(newError("new-required-request-property", checker.ERR, "added the new required request property 'data/name'"), "data/name"),
+		withProperty(newError("new-required-request-property", checker.ERR, "added the new required request property 'data/username'"), "data/username"),
+		withProperty(newError("new-required-request-property", checker.ERR, "added the new required request property 'name'"), "name"),
+	}, time.Now())
+	require.Equal(t, []checker.BackwardCompatibilityError{
+		withProperty(newError("new-required-request-property", checker.ERR, "added the new required request property 'data/username'"), "data/username"),
+	}, errs)
+	require.Empty(t, stale)
+}
+
+func TestIgnoreRulesNameLocalized(t *testing.T) {
+	rules, err := checker.ParseIgnoreRules([]byte("- id: request-parameter-removed\n  name: filter\n- id: required-response-header-removed\n  name: X-Rate-Limit"))
+	require.NoError(t, err)
+
+	// the names are matched regardless of the language of the text
+	newError := newOperationError("GET", "listPets", "/pets", "")
+	errs, stale := rules.Apply([]checker.BackwardCompatibilityError{
+		withParameter(newError("request-parameter-removed", checker.ERR, "удален 'query' параметр запроса 'filter'"), "filter"),
+		withParameter(newError("request-parameter-removed", checker.ERR, "удален 'query' параметр запроса 'limit'"), "limit"),
+		withHeader(newError("required-response-header-removed", checker.ERR, "удален заголовок ответа 'X-Rate-Limit'"), "X-Rate-Limit"),
+	}, time.Now())
+	require.Equal(t, []checker.BackwardCompatibilityError{
+		withParameter(newError("request-parameter-removed", checker.ERR, "удален 'query' параметр запроса 'limit'"), "limit"),
+	}, errs)
+	require.Empty(t, stale)
+}
@@ -1,6 +1,6 @@
 // Code generated by go-localize; DO NOT EDIT.
 // This file was generated by robots at
-// 2023-06-12 15:35:28.982549 +0100 IST m=+0.003894001
+// 2026-10-16 22:06:16.373004 +0000 UTC
 
 package localizations
 
@@ -12,248 +12,953 @@ import (
 )
 
 var localizations = map[string]string{
-	"en.messages.added-required-request-body":                              "added required request body",
-	"en.messages.api-deprecated-sunset-parse":                              "api sunset date '%s' can't be parsed for deprecated API: %v",
-	"en.messages.api-operation-id-removed":                                 "api operation id %s removed and replaced with %s",
-	"en.messages.api-path-removed-before-sunset":                           "api path removed before the sunset date %s",
-	"en.messages.api-path-removed-without-deprecation":                     "api path removed without deprecation",
-	"en.messages.api-removed-before-sunset":                                "api removed before the sunset date %s",
-	"en.messages.api-removed-without-deprecation":                          "api removed without deprecation",
-	"en.messages.api-schema-removed":                                       "removed the schema %s from openapi components",
-	"en.messages.api-sunset-date-changed-too-small":                        "api sunset date changed to earlier date from %s to %s, new sunset date must be not earlier than %s at least %d days from now",
-	"en.messages.api-sunset-date-too-small":                                "api sunset date '%s' is too small, must be at least %d days from now",
-	"en.messages.api-tag-removed":                                          "api tag %s removed",
-	"en.messages.at":                                                       "at",
-	"en.messages.endpoint-added":                                           "endpoint added",
-	"en.messages.endpoint-deprecated":                                      "endpoint deprecated",
-	"en.messages.endpoint-reactivated":                                     "endpoint reactivated",
-	"en.messages.in":                                                       "in",
-	"en.messages.new-optional-request-parameter":                           "added the new optional %s request parameter %s",
-	"en.messages.new-request-path-parameter":                               "added the new path request parameter %s",
-	"en.messages.new-required-request-header-property":                     "added the new required %s request header's property %s",
-	"en.messages.new-required-request-parameter":                           "added the new required %s request parameter %s",
-	"en.messages.new-required-request-property":                            "added the new required request property %s",
-	"en.messages.optional-response-header-removed":                         "the optional response header %s removed for the status %s",
-	"en.messages.pattern-changed-warn-comment":                             "This is a warning because it is difficult to automatically analyze if the new pattern is a superset of the previous pattern(e.g. changed from '[0-9]+' to '[0-9]*')",
-	"en.messages.request-allOf-modified":                                   "modified allOf for the request property %s",
-	"en.messages.request-allOf-modified-comment":                           "It is a warning because it is very difficult to check that allOf changed correctly without breaking changes",
-	"en.messages.request-body-became-enum":                                 "request body was restricted to a list of enum values",
-	"en.messages.request-body-became-not-nullable":                         "the request's body became not nullable",
-	"en.messages.request-body-became-required":                             "request body became required",
-	"en.messages.request-body-enum-value-removed":                          "request body enum value removed %s",
-	"en.messages.request-body-max-decreased":                               "the request's body max was decreased to %s",
-	"en.messages.request-body-max-length-decreased":                        "the request's body maxLength was decreased to %s",
-	"en.messages.request-body-max-length-set":                              "the request's body maxLength was set to %s",
-	"en.messages.request-body-max-length-set-comment":                      "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-body-max-set":                                     "the request's body max was set to %s",
-	"en.messages.request-body-max-set-comment":                             "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-body-min-increased":                               "the request's body min was increased to %s",
-	"en.messages.request-body-min-items-increased":                         "the request's body minItems was increased to %s",
-	"en.messages.request-body-min-items-set":                               "the request's body minItems was set to %s",
-	"en.messages.request-body-min-items-set-comment":                       "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-body-min-set":                                     "the request's body min was set to %s",
-	"en.messages.request-body-min-set-comment":                             "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-body-type-changed":                                "the request's body type/format changed from %s/%s to %s/%s",
-	"en.messages.request-header-property-became-enum":                      "the %s request header's property %s was restricted to a list of enum values",
-	"en.messages.request-header-property-became-required":                  "the %s request header's property %s became required",
-	"en.messages.request-parameter-became-enum":                            "the %s request parameter %s was restricted to a list of enum values",
-	"en.messages.request-parameter-became-optional":                        "the %s request parameter %s became optional",
-	"en.messages.request-parameter-became-required":                        "the %s request parameter %s became required",
-	"en.messages.request-parameter-default-value-changed":                  "for the %s request parameter %s, default value was changed from %s to %s",
-	"en.messages.request-parameter-enum-value-removed":                     "removed the enum value %s for the %s request parameter %s",
-	"en.messages.request-parameter-max-decreased":                          "for the %s request parameter %s, the max was decreased from %s to %s",
-	"en.messages.request-parameter-max-length-decreased":                   "for the %s request parameter %s, the maxLength was decreased from %s to %s",
-	"en.messages.request-parameter-max-length-set":                         "for the %s request parameter %s, the maxLength was set to %s",
-	"en.messages.request-parameter-max-length-set-comment":                 "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-parameter-max-set":                                "for the %s request parameter %s, the max was set to %s",
-	"en.messages.request-parameter-max-set-comment":                        "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-parameter-min-increased":                          "for the %s request parameter %s, the min was increased from %s to %s",
-	"en.messages.request-parameter-min-items-increased":                    "for the %s request parameter %s, the minItems was increased from %s to %s",
-	"en.messages.request-parameter-min-items-set":                          "for the %s request parameter %s, the minItems was set to %s",
-	"en.messages.request-parameter-min-items-set-comment":                  "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-parameter-min-set":                                "for the %s request parameter %s, the min was set to %s",
-	"en.messages.request-parameter-min-set-comment":                        "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-parameter-pattern-added":                          "added the pattern '%s' for the %s request parameter %s",
-	"en.messages.request-parameter-pattern-changed":                        "changed the pattern for the %s request parameter %s from '%s' to '%s'",
-	"en.messages.request-parameter-removed":                                "deleted the %s request parameter %s",
-	"en.messages.request-parameter-type-changed":                           "for the %s request parameter %s, the type/format was changed from %s/%s to %s/%s",
-	"en.messages.request-parameter-x-extensible-enum-value-removed":        "removed the x-extensible-enum value %s for the %s request parameter %s",
-	"en.messages.request-property-became-enum":                             "request property %s was restricted to a list of enum values",
-	"en.messages.request-property-became-not-nullable":                     "the request property %s became not nullable",
-	"en.messages.request-property-became-required":                         "the request property %s became required",
-	"en.messages.request-property-enum-value-removed":                      "removed the enum value %s of the request property %s",
-	"en.messages.request-property-max-decreased":                           "the %s request property's max was decreased to %s",
-	"en.messages.request-property-max-length-decreased":                    "the %s request property's maxLength was decreased to %s",
-	"en.messages.request-property-max-length-set":                          "the %s request property's maxLength was set to %s",
-	"en.messages.request-property-max-length-set-comment":                  "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-property-max-set":                                 "the %s request property's max was set to %s",
-	"en.messages.request-property-max-set-comment":                         "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-property-min-increased":                           "the %s request property's min was increased to %s",
-	"en.messages.request-property-min-items-increased":                     "the %s request property's minItems was increased to %s",
-	"en.messages.request-property-min-items-set":                           "the %s request property's minItems was set to %s",
-	"en.messages.request-property-min-items-set-comment":                   "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-property-min-set":                                 "the %s request property's min was set to %s",
-	"en.messages.request-property-min-set-comment":                         "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
-	"en.messages.request-property-pattern-added":                           "added the pattern '%s' for the request property %s",
-	"en.messages.request-property-pattern-changed":                         "changed the pattern for the request property %s from '%s' to '%s'",
-	"en.messages.request-property-removed":                                 "removed the request property %s",
-	"en.messages.request-property-type-changed":                            "the %s request property type/format changed from %s/%s to %s/%s",
-	"en.messages.request-property-x-extensible-enum-value-removed":         "removed the x-extensible-enum value '%s' of the request property %s",
-	"en.messages.required-response-header-removed":                         "the mandatory response header %s removed for the status %s",
-	"en.messages.response-body-became-nullable":                            "the response's body became nullable",
-	"en.messages.response-body-max-increased":                              "the response's body max was increased from %s to %s",
-	"en.messages.response-body-max-length-increased":                       "the response's body maxLength was increased from %s to %s",
-	"en.messages.response-body-max-length-unset":                           "the response's body maxLength was unset from %s",
-	"en.messages.response-body-min-decreased":                              "the response's body min was decreased from %s to %s",
-	"en.messages.response-body-min-items-decreased":                        "the response's body minItems was decreased from %s to %s",
-	"en.messages.response-body-min-items-unset":                            "the response's body minItems was unset from %s",
-	"en.messages.response-body-min-length-decreased":                       "the response's body minLength was decreased from %s to %s",
-	"en.messages.response-body-type-changed":                               "the response's body type/format changed from %s/%s to %s/%s for status %s",
-	"en.messages.response-header-became-optional":                          "the response header %s became optional for the status %s",
-	"en.messages.response-media-type-removed":                              "removed the media type %s for the response with the status %s",
-	"en.messages.response-mediatype-enum-value-removed":                    "response schema %s enum value removed %s",
-	"en.messages.response-non-success-status-removed":                      "removed the non-success response with the status %s",
-	"en.messages.response-optional-property-removed":                       "removed the optional property %s from the response with the %s status",
-	"en.messages.response-property-became-nullable":                        "the response property %s became nullable for the status %s",
-	"en.messages.response-property-became-optional":                        "the response property %s became optional for the status %s",
-	"en.messages.response-property-enum-value-added":                       "added the new '%s' enum value the %s response property for the response status %s",
-	"en.messages.response-property-enum-value-added-comment":               "Adding new enum values to response could be unexpected for clients, use x-extensible-enum instead.",
-	"en.messages.response-property-enum-value-removed":                     "removed the '%s' enum value from the %s response property for the response status %s",
-	"en.messages.response-property-max-increased":                          "the %s response property's max was increased from %s to %s for the response status %s",
-	"en.messages.response-property-max-length-increased":                   "the %s response property's maxLength was increased from %s to %s for the response status %s",
-	"en.messages.response-property-max-length-unset":                       "the %s response property's maxLength was unset from %s for the response status %s",
-	"en.messages.response-property-min-decreased":                          "the %s response property's min was decreased from %s to %s for the response status %s",
-	"en.messages.response-property-min-items-decreased":                    "the %s response property's minItems was decreased from %s to %s for the response status %s",
-	"en.messages.response-property-min-items-unset":                        "the %s response property's minItems was unset from %s for the response status %s",
-	"en.messages.response-property-min-length-decreased":                   "the %s response property's minLength was decreased from %s to %s for the response status %s",
-	"en.messages.response-property-type-changed":                           "the response's property type/format changed from %s/%s to %s/%s for status %s",
-	"en.messages.response-required-property-became-not-write-only":         "the response required property %s became not write-only for the status %s",
-	"en.messages.response-required-property-became-not-write-only-comment": "It is valid only if the property was always returned before the specification has been changed",
-	"en.messages.response-required-property-removed":                       "removed the required property %s from the response with the %s status",
-	"en.messages.response-success-status-removed":                          "removed the success response with the status %s",
-	"en.messages.sunset-deleted":                                           "api sunset date deleted, but deprecated=true kept",
-	"en.messages.total-errors":                                             "Backward compatibility errors (%d):\n",
-	"ru.messages.added-required-request-body":                              "добавлено обязательное тело запроса",
-	"ru.messages.api-deprecated-sunset-parse":                              "API deprecated без валидно парсящейся '%s' даты sunset: %v",
-	"ru.messages.api-operation-id-removed":                                 "Идентификатор операции API %s удален и заменен на %s",
-	"ru.messages.api-path-added":                                           "API path добавлено",
-	"ru.messages.api-path-deprecated":                                      "API path deprecated",
-	"ru.messages.api-path-reactivated":                                     "API path реактивирован",
-	"ru.messages.api-path-removed-before-sunset":                           "API path удалён до даты sunset %s",
-	"ru.messages.api-path-removed-without-deprecation":                     "api path удалён без процедуры deprecation",
-	"ru.messages.api-removed-before-sunset":                                "API удалёг до даты sunset %s",
-	"ru.messages.api-removed-without-deprecation":                          "API удалён без deprecation",
-	"ru.messages.api-schema-removed":                                       "удалена схема %s из компонентов openapi",
-	"ru.messages.api-sunset-date-changed-too-small":                        "дата sunset у API изменена на более раннюю с %s на %s, новая дата sunset должна быть либо не раньше %s, либо, как минимум, %d дней от текущего дня",
-	"ru.messages.api-sunset-date-too-small":                                "дата API sunset date '%s' слишком ранняя, должно быть как минимум %d дней от текущего дня",
-	"ru.messages.api-tag-removed":                                          "Тег API %s удален",
-	"ru.messages.at":                                                       "в",
-	"ru.messages.in":                                                       "в",
-	"ru.messages.new-optional-request-parameter":                           "добавлен новый необязательный %s параметр зароса %s",
-	"ru.messages.new-request-path-parameter":                               "добален новый path параметр запроса %s",
-	"ru.messages.new-required-request-header-property":                     "в заголовке запроса %s добавлено новое обязательное поле %s",
-	"ru.messages.new-required-request-parameter":                           "добавлен новый обязательный %s параметр зароса %s",
-	"ru.messages.new-required-request-property":                            "добавлено новле обязательное поле запроса %s",
-	"ru.messages.optional-response-header-removed":                         "удалён ранее необязательный заголовок ответа %s для ответа со статусом %s",
-	"ru.messages.pattern-changed-warn-comment":                             "Это предупреждение, потому что сложно автоматически проанализировать, является ли новый шаблон надмножеством предыдущего шаблона (например, изменен с '[0-9]+' на '[0-9]*').",
-	"ru.messages.request-allOf-modified":                                   "изменено allOf для поля запроса %s",
-	"ru.messages.request-allOf-modified-comment":                           "Это предупреждение, потому что очень сложно алгоритмически автоматизированно проверить правильность изменения allOf на обратную совместимость.",
-	"ru.messages.request-body-became-enum":                                 "тело запроса было ограничено списком значений перечисления",
-	"ru.messages.request-body-became-not-nullable":                         "тело запроса стало недействительным",
-	"ru.messages.request-body-became-required":                             "тело запроса стало обязательным",
-	"ru.messages.request-body-enum-value-removed":                          "значение перечисления тела запроса удалено %s",
-	"ru.messages.request-body-max-decreased":                               "значение max у тела запроса уменьшено до %s",
-	"ru.messages.request-body-max-length-decreased":                        "значение maxLength у тела запроса уменьшено до %s",
-	"ru.messages.request-body-max-length-set":                              "у тела запроса задано значение maxLength в %s",
-	"ru.messages.request-body-max-length-set-comment":                      "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-body-max-set":                                     "у тела запроса задано значение max в %s",
-	"ru.messages.request-body-max-set-comment":                             "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-body-min-increased":                               "значение min у тела запроса увеличено до %s",
-	"ru.messages.request-body-min-items-increased":                         "значение minItems у тела запроса увеличено до %s",
-	"ru.messages.request-body-min-items-set":                               "задано значение minItems у тела запроса в %s",
-	"ru.messages.request-body-min-items-set-comment":                       "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-body-min-set":                                     "задано значение min у тела запроса в %s",
-	"ru.messages.request-body-min-set-comment":                             "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-body-type-changed":                                "изменился type/format тела запроса с %s/%s на %s/%s",
-	"ru.messages.request-header-property-became-enum":                      "свойство %s заголовка запроса %s было ограничено списком значений перечисления",
-	"ru.messages.request-header-property-became-required":                  "в заголовке запроса %s поле %s стало обязательным",
-	"ru.messages.request-parameter-became-enum":                            "заголовок запроса %s поле %s было ограничено списком значений перечисления",
-	"ru.messages.request-parameter-became-optional":                        "ранее необязательный параметр запроса %s %s теперь является необязательным",
-	"ru.messages.request-parameter-became-required":                        "ранее необязательный %s параметр запроса %s стал обязательным",
-	"ru.messages.request-parameter-default-value-changed":                  "в %s параметре запроса %s, значение по умолчанию изменено с %s на %s",
-	"ru.messages.request-parameter-enum-value-removed":                     "удалено значение enum %s у %s параметра запроса %s",
-	"ru.messages.request-parameter-max-decreased":                          "в %s параметре запроса %s, max уменьшен с %s до %s",
-	"ru.messages.request-parameter-max-length-decreased":                   "в %s параметре запроса %s, maxLength уменьшен с %s до %s",
-	"ru.messages.request-parameter-max-length-set":                         "в %s параметре запроса %s, maxLength установлен в %s",
-	"ru.messages.request-parameter-max-length-set-comment":                 "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-parameter-max-set":                                "в %s параметре запроса %s, max установлен в %s",
-	"ru.messages.request-parameter-max-set-comment":                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-parameter-min-increased":                          "в %s параметре запроса %s, min увеличен с %s до %s",
-	"ru.messages.request-parameter-min-items-increased":                    "в %s параметре запроса %s, minItems увеличен с %s до %s",
-	"ru.messages.request-parameter-min-items-set":                          "в %s параметре запроса %s, minItems установлен в %s",
-	"ru.messages.request-parameter-min-items-set-comment":                  "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-parameter-min-set":                                "в %s параметре запроса %s, min установлен в %s",
-	"ru.messages.request-parameter-min-set-comment":                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-parameter-pattern-added":                          "добавлен pattern '%s' у %s параметра запроса %s",
-	"ru.messages.request-parameter-pattern-changed":                        "изменён pattern у %s параметра запроса %s со значения '%s' на значение '%s'",
-	"ru.messages.request-parameter-removed":                                "удалён %s параметр запроса %s",
-	"ru.messages.request-parameter-type-changed":                           "в %s параметре запроса %s, type/format изменился с %s/%s на %s/%s",
-	"ru.messages.request-parameter-x-extensible-enum-value-removed":        "удалено из x-extensible-enum значение %s у %s параметра запроса %s",
-	"ru.messages.request-property-became-enum":                             "свойство запроса %s было ограничено списком значений перечисления",
-	"ru.messages.request-property-became-not-nullable":                     "свойство запроса %s стало недействительным",
-	"ru.messages.request-property-became-required":                         "поле запроса %s стало обязательным",
-	"ru.messages.request-property-enum-value-removed":                      "удалено enum значение %s у поля запроса %s",
-	"ru.messages.request-property-max-decreased":                           "значение max у поля запроса %s уменьшено до %s",
-	"ru.messages.request-property-max-length-decreased":                    "значение maxLength у поля запроса %s уменьшено до %s",
-	"ru.messages.request-property-max-length-set":                          "у поля запроса %s задано значение maxLength в %s",
-	"ru.messages.request-property-max-length-set-comment":                  "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-property-max-set":                                 "у поля запроса %s задано значение max в %s",
-	"ru.messages.request-property-max-set-comment":                         "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-property-min-increased":                           "у поля запроса %s, увеличено значение min до %s",
-	"ru.messages.request-property-min-items-increased":                     "значение minItems у поля запроса %s увеличено до %s",
-	"ru.messages.request-property-min-items-set":                           "у поля запроса %s задано значение minItems в %s",
-	"ru.messages.request-property-min-items-set-comment":                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-property-min-set":                                 "у поля запроса %s задано значение min в %s",
-	"ru.messages.request-property-min-set-comment":                         "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
-	"ru.messages.request-property-pattern-added":                           "добавлен pattern '%s' у поля запроса %s",
-	"ru.messages.request-property-pattern-changed":                         "изменён pattern у поля запроса %s со значения '%s' на значение '%s'",
-	"ru.messages.request-property-removed":                                 "удалено поле запроса %s",
-	"ru.messages.request-property-type-changed":                            "у поля запроса %s изменился type/format с %s/%s на %s/%s",
-	"ru.messages.request-property-x-extensible-enum-value-removed":         "удалено значение x-extensible-enum '%s' в поле запроса %s",
-	"ru.messages.required-response-header-removed":                         "удалён ранее обязательный заголовок ответа %s для ответа со статусом %s",
-	"ru.messages.response-body-became-nullable":                            "у тела ответа стало обнуляемым",
-	"ru.messages.response-body-max-increased":                              "у тела ответа max увеличен с %s до %s",
-	"ru.messages.response-body-max-length-increased":                       "у тела ответа maxLength увеличен с %s до %s",
-	"ru.messages.response-body-max-length-unset":                           "у тела ответа maxLength был удалён, предыдущее значение - %s",
-	"ru.messages.response-body-min-decreased":                              "у тела ответа min уменьшено с %s до %s",
-	"ru.messages.response-body-min-items-decreased":                        "у тела ответа minItems уменьшено с %s до %s",
-	"ru.messages.response-body-min-items-unset":                            "удалено значение minItems для тела ответа, предыдущее значение - %s",
-	"ru.messages.response-body-min-length-decreased":                       "значение minLength для тела ответа уменьшено с %s до %s",
-	"ru.messages.response-body-type-changed":                               "у тела ответа type/format изменился с %s/%s на %s/%s для ответа со статусом %s",
-	"ru.messages.response-header-became-optional":                          "заголовок ответа %s стал необязательным для ответа со статусом %s",
-	"ru.messages.response-media-type-removed":                              "удалён media type %s для ответа со статусом %s",
-	"ru.messages.response-mediatype-enum-value-removed":                    "значение перечисления схемы ответа %s удалено %s",
-	"ru.messages.response-non-success-status-removed":                      "удален неуспешный (не 2xx) статус ответа %s",
-	"ru.messages.response-optional-property-removed":                       "удалено необязательное поле %s из ответа со статусом %s",
-	"ru.messages.response-property-became-nullable":                        "поле ответа %s стало обнуляемым для ответа со статусом %s",
-	"ru.messages.response-property-became-optional":                        "поле ответа %s стало необязательным для ответа со статусом %s",
-	"ru.messages.response-property-enum-value-added":                       "добавлено новое enum значение %s в поле ответа %s для ответа со статусом %s",
-	"ru.messages.response-property-enum-value-added-comment":               "Добавление новых значений перечисления в ответ может быть неожиданным для клиентов, вместо этого используйте x-extensible-enum.",
-	"ru.messages.response-property-enum-value-removed":                     "удалено значение перечисления '%s' из свойства ответа %s для статуса ответа %s.",
-	"ru.messages.response-property-max-increased":                          "у поля ответа %s max увеличен с %s до %s для ответа со статусом %s",
-	"ru.messages.response-property-max-length-increased":                   "у поля ответа %s maxLength увеличен с %s до %s для ответа со статусом %s",
-	"ru.messages.response-property-max-length-unset":                       "у поля ответа %s maxLength был удалён, предыдущее значение - %s, для ответа со статусом %s",
-	"ru.messages.response-property-min-decreased":                          "для поля ответа %s min уменьшен с %s до %s для ответа со статусом %s",
-	"ru.messages.response-property-min-items-decreased":                    "у поля ответа %s уменьшено minItems с %s до %s для ответа со статусом %s",
-	"ru.messages.response-property-min-items-unset":                        "у поля ответа %s удалено значение minItems, предыдущее значение - %s, для ответа со статусом %s",
-	"ru.messages.response-property-min-length-decreased":                   "для поля ответа %s minLength уменьшен с %s до %s для ответа со статусом %s",
-	"ru.messages.response-property-type-changed":                           "у поля type/format изменился с %s/%s на %s/%s для ответа со статусом %s",
-	"ru.messages.response-required-property-became-not-write-only":         "обязательное поле ответа %s перестало быть write-only для ответа со статусом %s",
-	"ru.messages.response-required-property-became-not-write-only-comment": "Изменение допустимо только в том случае, если свойство ВСЕГДА возвращалось ДО изменения спецификации.",
-	"ru.messages.response-required-property-removed":                       "удалено обязательное поле ответа %s из ответа со статусом %s",
-	"ru.messages.response-success-status-removed":                          "удален успешный (2xx) статус ответа %s",
-	"ru.messages.sunset-deleted":                                           "удалена дата sunset date у API, но сохранён deprecated=true",
-	"ru.messages.total-errors":                                             "Ошибки обратной совместимости (всего: %d):\n",
+	"en.descriptions.added-required-request-body":                           "A required request body was added",
+	"en.descriptions.api-deprecated-sunset-parse":                           "The sunset date of a deprecated endpoint can't be parsed",
+	"en.descriptions.api-global-security-added":                             "An alternative global security requirement was added",
+	"en.descriptions.api-global-security-became-optional":                   "Global security became optional",
+	"en.descriptions.api-global-security-became-required":                   "Global security became required",
+	"en.descriptions.api-global-security-removed":                           "A global security requirement was removed",
+	"en.descriptions.api-global-security-scope-added":                       "A required scope was added to a global security requirement",
+	"en.descriptions.api-global-security-scope-removed":                     "A scope was removed from a global security requirement",
+	"en.descriptions.api-operation-endpoint-changed":                        "The endpoint of an operation was changed, the operation was matched by its operationId",
+	"en.descriptions.api-operation-id-removed":                              "The operation id of an endpoint was removed or changed",
+	"en.descriptions.api-path-removed-before-sunset":                        "A path was removed before its sunset date",
+	"en.descriptions.api-path-removed-without-deprecation":                  "A path was removed without deprecation",
+	"en.descriptions.api-path-sunset-parse":                                 "The sunset date of a removed path can't be parsed",
+	"en.descriptions.api-removed-before-sunset":                             "An endpoint was removed before its sunset date",
+	"en.descriptions.api-removed-without-deprecation":                       "An endpoint was removed without deprecation",
+	"en.descriptions.api-schema-removed":                                    "A schema was removed from the components",
+	"en.descriptions.api-security-added":                                    "An alternative security requirement was added to an endpoint",
+	"en.descriptions.api-security-became-optional":                          "Security became optional for an endpoint",
+	"en.descriptions.api-security-became-required":                          "Security became required for an endpoint",
+	"en.descriptions.api-security-component-added":                          "A security scheme was added to the components",
+	"en.descriptions.api-security-component-bearer-format-changed":          "The bearer format of a security scheme was changed",
+	"en.descriptions.api-security-component-in-changed":                     "The api key location of a security scheme was changed",
+	"en.descriptions.api-security-component-name-changed":                   "The api key name of a security scheme was changed",
+	"en.descriptions.api-security-component-oauth-flow-added":               "An oauth flow was added to a security scheme",
+	"en.descriptions.api-security-component-oauth-flow-removed":             "An oauth flow was removed from a security scheme",
+	"en.descriptions.api-security-component-oauth-scope-added":              "A scope was added to an oauth flow",
+	"en.descriptions.api-security-component-oauth-scope-removed":            "A scope was removed from an oauth flow",
+	"en.descriptions.api-security-component-oauth-url-changed":              "A url of an oauth flow was changed",
+	"en.descriptions.api-security-component-openid-url-changed":             "The openid connect url of a security scheme was changed",
+	"en.descriptions.api-security-component-removed":                        "A security scheme was removed from the components",
+	"en.descriptions.api-security-component-scheme-changed":                 "The http scheme of a security scheme was changed",
+	"en.descriptions.api-security-component-type-changed":                   "The type of a security scheme was changed",
+	"en.descriptions.api-security-removed":                                  "A security requirement was removed from an endpoint",
+	"en.descriptions.api-security-scope-added":                              "A required scope was added to a security requirement of an endpoint",
+	"en.descriptions.api-security-scope-removed":                            "A scope was removed from a security requirement of an endpoint",
+	"en.descriptions.api-stability-decreased":                               "The stability level of an endpoint was decreased",
+	"en.descriptions.api-sunset-date-changed-too-small":                     "The sunset date of a deprecated endpoint was changed to an earlier date",
+	"en.descriptions.api-sunset-date-too-small":                             "The sunset date of a deprecated endpoint is too close",
+	"en.descriptions.api-tag-removed":                                       "A tag was removed from an endpoint",
+	"en.descriptions.callback-added":                                        "A callback was added",
+	"en.descriptions.callback-operation-added":                              "An operation was added to a callback",
+	"en.descriptions.callback-operation-removed":                            "An operation was removed from a callback",
+	"en.descriptions.callback-path-added":                                   "An expression was added to a callback",
+	"en.descriptions.callback-path-removed":                                 "An expression was removed from a callback",
+	"en.descriptions.callback-removed":                                      "A callback was removed",
+	"en.descriptions.callback-request-required-property-added":              "A required property was added to the request body of a callback",
+	"en.descriptions.endpoint-added":                                        "An endpoint was added",
+	"en.descriptions.endpoint-deprecated":                                   "An endpoint was deprecated",
+	"en.descriptions.endpoint-reactivated":                                  "A deprecated endpoint was reactivated",
+	"en.descriptions.inverted-callback-request":                             "In the request body of a callback: %s",
+	"en.descriptions.inverted-callback-response":                            "In a response of a callback: %s",
+	"en.descriptions.inverted-webhook-request":                              "In the request body of a webhook: %s",
+	"en.descriptions.inverted-webhook-response":                             "In a response of a webhook: %s",
+	"en.descriptions.new-optional-request-parameter":                        "An optional request parameter was added",
+	"en.descriptions.new-request-path-parameter":                            "A path parameter was added",
+	"en.descriptions.new-required-request-header-property":                  "A required property was added to a request header",
+	"en.descriptions.new-required-request-parameter":                        "A required request parameter was added",
+	"en.descriptions.new-required-request-property":                         "A required request property was added",
+	"en.descriptions.optional-response-header-removed":                      "An optional response header was removed",
+	"en.descriptions.parsing-error":                                         "The spec contains a value which can't be parsed",
+	"en.descriptions.request-allOf-modified":                                "The allOf of a request property was modified",
+	"en.descriptions.request-body-additional-properties-disallowed":         "The request body no longer allows additional properties",
+	"en.descriptions.request-body-any-of-added":                             "A schema was added to the anyOf list of the request body",
+	"en.descriptions.request-body-any-of-removed":                           "A schema was removed from the anyOf list of the request body",
+	"en.descriptions.request-body-became-enum":                              "The request body was restricted to a list of enum values",
+	"en.descriptions.request-body-became-not-nullable":                      "The request body became not nullable",
+	"en.descriptions.request-body-became-required":                          "The request body became required",
+	"en.descriptions.request-body-default-value-added":                      "A default value was added to an optional request body",
+	"en.descriptions.request-body-default-value-changed":                    "The default value of an optional request body was changed",
+	"en.descriptions.request-body-default-value-removed":                    "The default value of an optional request body was removed",
+	"en.descriptions.request-body-discriminator-added":                      "A discriminator was added to the request body",
+	"en.descriptions.request-body-discriminator-mapping-added":              "A discriminator mapping value was added to the request body",
+	"en.descriptions.request-body-discriminator-mapping-changed":            "The schema of a discriminator mapping value of the request body was changed",
+	"en.descriptions.request-body-discriminator-mapping-removed":            "A discriminator mapping value was removed from the request body",
+	"en.descriptions.request-body-discriminator-property-name-changed":      "The discriminator property name of the request body was changed",
+	"en.descriptions.request-body-discriminator-removed":                    "The discriminator was removed from the request body",
+	"en.descriptions.request-body-enum-value-removed":                       "An enum value was removed from the request body",
+	"en.descriptions.request-body-max-became-exclusive":                     "The maximum of the request body became exclusive",
+	"en.descriptions.request-body-max-decreased":                            "The max value of the request body was decreased",
+	"en.descriptions.request-body-max-items-decreased":                      "The maxItems of the request body was decreased",
+	"en.descriptions.request-body-max-items-set":                            "The maxItems of the request body was set",
+	"en.descriptions.request-body-max-length-decreased":                     "The maxLength of the request body was decreased",
+	"en.descriptions.request-body-max-length-set":                           "A maxLength was set for the request body",
+	"en.descriptions.request-body-max-properties-decreased":                 "The maxProperties of the request body was decreased",
+	"en.descriptions.request-body-max-properties-set":                       "The maxProperties of the request body was set",
+	"en.descriptions.request-body-max-set":                                  "A max value was set for the request body",
+	"en.descriptions.request-body-media-type-added":                         "A request body media type was added",
+	"en.descriptions.request-body-media-type-removed":                       "A request body media type was removed",
+	"en.descriptions.request-body-min-became-exclusive":                     "The minimum of the request body became exclusive",
+	"en.descriptions.request-body-min-increased":                            "The min value of the request body was increased",
+	"en.descriptions.request-body-min-items-increased":                      "The minItems of the request body was increased",
+	"en.descriptions.request-body-min-items-set":                            "A minItems was set for the request body",
+	"en.descriptions.request-body-min-properties-increased":                 "The minProperties of the request body was increased",
+	"en.descriptions.request-body-min-set":                                  "A min value was set for the request body",
+	"en.descriptions.request-body-multiple-of-changed":                      "The multipleOf of the request body was changed to a value which isn't compatible with the original one",
+	"en.descriptions.request-body-multiple-of-set":                          "The multipleOf of the request body was set",
+	"en.descriptions.request-body-not-modified":                             "The not schema of the request body was modified",
+	"en.descriptions.request-body-one-of-added":                             "A schema was added to the oneOf list of the request body",
+	"en.descriptions.request-body-one-of-removed":                           "A schema was removed from the oneOf list of the request body",
+	"en.descriptions.request-body-schema-changed-partially":                 "The request body schema was changed in some media types but not in others",
+	"en.descriptions.request-body-type-changed":                             "The type or format of the request body was changed",
+	"en.descriptions.request-body-unique-items-set":                         "The uniqueItems of the request body was set",
+	"en.descriptions.request-header-property-became-enum":                   "A request header property was restricted to a list of enum values",
+	"en.descriptions.request-header-property-became-required":               "A request header property became required",
+	"en.descriptions.request-header-property-default-value-added":           "A default value was added to an optional request header property",
+	"en.descriptions.request-header-property-default-value-changed":         "The default value of an optional request header property was changed",
+	"en.descriptions.request-header-property-default-value-removed":         "The default value of an optional request header property was removed",
+	"en.descriptions.request-parameter-allow-reserved-disabled":             "A query request parameter no longer allows reserved characters",
+	"en.descriptions.request-parameter-became-enum":                         "A request parameter was restricted to a list of enum values",
+	"en.descriptions.request-parameter-became-optional":                     "A required request parameter became optional",
+	"en.descriptions.request-parameter-became-required":                     "An optional request parameter became required",
+	"en.descriptions.request-parameter-default-value-changed":               "The default value of a request parameter was changed",
+	"en.descriptions.request-parameter-enum-value-removed":                  "An enum value was removed from a request parameter",
+	"en.descriptions.request-parameter-explode-changed":                     "The explode of a request parameter was changed",
+	"en.descriptions.request-parameter-max-became-exclusive":                "The maximum of a request parameter became exclusive",
+	"en.descriptions.request-parameter-max-decreased":                       "The max value of a request parameter was decreased",
+	"en.descriptions.request-parameter-max-items-decreased":                 "The maxItems of a request parameter was decreased",
+	"en.descriptions.request-parameter-max-items-set":                       "The maxItems of a request parameter was set",
+	"en.descriptions.request-parameter-max-length-decreased":                "The maxLength of a request parameter was decreased",
+	"en.descriptions.request-parameter-max-length-set":                      "A maxLength was set for a request parameter",
+	"en.descriptions.request-parameter-max-properties-decreased":            "The maxProperties of a request parameter was decreased",
+	"en.descriptions.request-parameter-max-properties-set":                  "The maxProperties of a request parameter was set",
+	"en.descriptions.request-parameter-max-set":                             "A max value was set for a request parameter",
+	"en.descriptions.request-parameter-min-became-exclusive":                "The minimum of a request parameter became exclusive",
+	"en.descriptions.request-parameter-min-increased":                       "The min value of a request parameter was increased",
+	"en.descriptions.request-parameter-min-items-increased":                 "The minItems of a request parameter was increased",
+	"en.descriptions.request-parameter-min-items-set":                       "A minItems was set for a request parameter",
+	"en.descriptions.request-parameter-min-properties-increased":            "The minProperties of a request parameter was increased",
+	"en.descriptions.request-parameter-min-set":                             "A min value was set for a request parameter",
+	"en.descriptions.request-parameter-moved":                               "A request parameter was moved to another location",
+	"en.descriptions.request-parameter-multiple-of-changed":                 "The multipleOf of a request parameter was changed to a value which isn't compatible with the original one",
+	"en.descriptions.request-parameter-multiple-of-set":                     "The multipleOf of a request parameter was set",
+	"en.descriptions.request-parameter-pattern-added":                       "A pattern was added to a request parameter",
+	"en.descriptions.request-parameter-pattern-changed":                     "The pattern of a request parameter was changed",
+	"en.descriptions.request-parameter-removed":                             "A request parameter was removed",
+	"en.descriptions.request-parameter-renamed":                             "A request parameter was renamed",
+	"en.descriptions.request-parameter-style-changed":                       "The serialization style of a request parameter was changed",
+	"en.descriptions.request-parameter-type-changed":                        "The type or format of a request parameter was changed",
+	"en.descriptions.request-parameter-unique-items-set":                    "The uniqueItems of a request parameter was set",
+	"en.descriptions.request-parameter-x-extensible-enum-value-removed":     "An x-extensible-enum value was removed from a request parameter",
+	"en.descriptions.request-property-additional-properties-disallowed":     "A request property no longer allows additional properties",
+	"en.descriptions.request-property-any-of-added":                         "A schema was added to the anyOf list of a request property",
+	"en.descriptions.request-property-any-of-removed":                       "A schema was removed from the anyOf list of a request property",
+	"en.descriptions.request-property-became-enum":                          "A request property was restricted to a list of enum values",
+	"en.descriptions.request-property-became-not-nullable":                  "A request property became not nullable",
+	"en.descriptions.request-property-became-not-read-only":                 "An optional request property is no longer read-only",
+	"en.descriptions.request-property-became-read-only":                     "A request property became read-only",
+	"en.descriptions.request-property-became-required":                      "An optional request property became required",
+	"en.descriptions.request-property-default-value-added":                  "A default value was added to an optional request property",
+	"en.descriptions.request-property-default-value-changed":                "The default value of an optional request property was changed",
+	"en.descriptions.request-property-default-value-removed":                "The default value of an optional request property was removed",
+	"en.descriptions.request-property-discriminator-added":                  "A discriminator was added to a request property",
+	"en.descriptions.request-property-discriminator-mapping-added":          "A discriminator mapping value was added to a request property",
+	"en.descriptions.request-property-discriminator-mapping-changed":        "The schema of a discriminator mapping value of a request property was changed",
+	"en.descriptions.request-property-discriminator-mapping-removed":        "A discriminator mapping value was removed from a request property",
+	"en.descriptions.request-property-discriminator-property-name-changed":  "The discriminator property name of a request property was changed",
+	"en.descriptions.request-property-discriminator-removed":                "The discriminator was removed from a request property",
+	"en.descriptions.request-property-enum-value-removed":                   "An enum value was removed from a request property",
+	"en.descriptions.request-property-max-became-exclusive":                 "The maximum of a request property became exclusive",
+	"en.descriptions.request-property-max-decreased":                        "The max value of a request property was decreased",
+	"en.descriptions.request-property-max-items-decreased":                  "The maxItems of a request property was decreased",
+	"en.descriptions.request-property-max-items-set":                        "The maxItems of a request property was set",
+	"en.descriptions.request-property-max-length-decreased":                 "The maxLength of a request property was decreased",
+	"en.descriptions.request-property-max-length-set":                       "A maxLength was set for a request property",
+	"en.descriptions.request-property-max-properties-decreased":             "The maxProperties of a request property was decreased",
+	"en.descriptions.request-property-max-properties-set":                   "The maxProperties of a request property was set",
+	"en.descriptions.request-property-max-set":                              "A max value was set for a request property",
+	"en.descriptions.request-property-min-became-exclusive":                 "The minimum of a request property became exclusive",
+	"en.descriptions.request-property-min-increased":                        "The min value of a request property was increased",
+	"en.descriptions.request-property-min-items-increased":                  "The minItems of a request property was increased",
+	"en.descriptions.request-property-min-items-set":                        "A minItems was set for a request property",
+	"en.descriptions.request-property-min-properties-increased":             "The minProperties of a request property was increased",
+	"en.descriptions.request-property-min-set":                              "A min value was set for a request property",
+	"en.descriptions.request-property-multiple-of-changed":                  "The multipleOf of a request property was changed to a value which isn't compatible with the original one",
+	"en.descriptions.request-property-multiple-of-set":                      "The multipleOf of a request property was set",
+	"en.descriptions.request-property-not-modified":                         "The not schema of a request property was modified",
+	"en.descriptions.request-property-one-of-added":                         "A schema was added to the oneOf list of a request property",
+	"en.descriptions.request-property-one-of-removed":                       "A schema was removed from the oneOf list of a request property",
+	"en.descriptions.request-property-pattern-added":                        "A pattern was added to a request property",
+	"en.descriptions.request-property-pattern-changed":                      "The pattern of a request property was changed",
+	"en.descriptions.request-property-removed":                              "A request property was removed",
+	"en.descriptions.request-property-type-changed":                         "The type or format of a request property was changed",
+	"en.descriptions.request-property-unique-items-set":                     "The uniqueItems of a request property was set",
+	"en.descriptions.request-property-x-extensible-enum-value-removed":      "An x-extensible-enum value was removed from a request property",
+	"en.descriptions.request-required-property-became-not-read-only":        "A required request property is no longer read-only",
+	"en.descriptions.request-required-property-became-read-only":            "A required request property became read-only",
+	"en.descriptions.required-response-header-removed":                      "A required response header was removed",
+	"en.descriptions.response-allOf-modified":                               "The allOf of a response property was modified",
+	"en.descriptions.response-body-any-of-added":                            "A schema was added to the anyOf list of a response body",
+	"en.descriptions.response-body-any-of-removed":                          "A schema was removed from the anyOf list of a response body",
+	"en.descriptions.response-body-became-nullable":                         "The response body became nullable",
+	"en.descriptions.response-body-discriminator-added":                     "A discriminator was added to a response body",
+	"en.descriptions.response-body-discriminator-mapping-added":             "A discriminator mapping value was added to a response body",
+	"en.descriptions.response-body-discriminator-mapping-changed":           "The schema of a discriminator mapping value of a response body was changed",
+	"en.descriptions.response-body-discriminator-mapping-removed":           "A discriminator mapping value was removed from a response body",
+	"en.descriptions.response-body-discriminator-property-name-changed":     "The discriminator property name of a response body was changed",
+	"en.descriptions.response-body-discriminator-removed":                   "The discriminator was removed from a response body",
+	"en.descriptions.response-body-max-became-inclusive":                    "The maximum of a response body became inclusive",
+	"en.descriptions.response-body-max-increased":                           "The max value of the response body was increased",
+	"en.descriptions.response-body-max-items-increased":                     "The maxItems of a response body was increased",
+	"en.descriptions.response-body-max-items-unset":                         "The maxItems of a response body was unset",
+	"en.descriptions.response-body-max-length-increased":                    "The maxLength of the response body was increased",
+	"en.descriptions.response-body-max-length-unset":                        "The maxLength of the response body was removed",
+	"en.descriptions.response-body-max-properties-increased":                "The maxProperties of a response body was increased",
+	"en.descriptions.response-body-max-properties-unset":                    "The maxProperties of a response body was unset",
+	"en.descriptions.response-body-min-became-inclusive":                    "The minimum of a response body became inclusive",
+	"en.descriptions.response-body-min-decreased":                           "The min value of the response body was decreased",
+	"en.descriptions.response-body-min-items-decreased":                     "The minItems of the response body was decreased",
+	"en.descriptions.response-body-min-items-unset":                         "The minItems of the response body was removed",
+	"en.descriptions.response-body-min-length-decreased":                    "The minLength of the response body was decreased",
+	"en.descriptions.response-body-min-properties-decreased":                "The minProperties of a response body was decreased",
+	"en.descriptions.response-body-multiple-of-changed":                     "The multipleOf of a response body was changed to a value which isn't compatible with the original one",
+	"en.descriptions.response-body-multiple-of-unset":                       "The multipleOf of a response body was unset",
+	"en.descriptions.response-body-not-modified":                            "The not schema of the response body was modified",
+	"en.descriptions.response-body-one-of-added":                            "A schema was added to the oneOf list of a response body",
+	"en.descriptions.response-body-one-of-removed":                          "A schema was removed from the oneOf list of a response body",
+	"en.descriptions.response-body-type-changed":                            "The type or format of the response body was changed",
+	"en.descriptions.response-body-unique-items-unset":                      "The uniqueItems of a response body was unset",
+	"en.descriptions.response-header-became-optional":                       "A required response header became optional",
+	"en.descriptions.response-header-enum-value-added":                      "An enum value was added to a response header",
+	"en.descriptions.response-header-enum-value-removed":                    "An enum value was removed from a response header",
+	"en.descriptions.response-header-max-became-inclusive":                  "The maximum of a response header became inclusive",
+	"en.descriptions.response-header-max-increased":                         "The max value of a response header was increased",
+	"en.descriptions.response-header-max-items-increased":                   "The maxItems of a response header was increased",
+	"en.descriptions.response-header-max-items-unset":                       "The maxItems of a response header was unset",
+	"en.descriptions.response-header-max-length-increased":                  "The maxLength of a response header was increased",
+	"en.descriptions.response-header-max-length-unset":                      "The maxLength of a response header was removed",
+	"en.descriptions.response-header-max-properties-increased":              "The maxProperties of a response header was increased",
+	"en.descriptions.response-header-max-properties-unset":                  "The maxProperties of a response header was unset",
+	"en.descriptions.response-header-max-unset":                             "The max value of a response header was removed",
+	"en.descriptions.response-header-min-became-inclusive":                  "The minimum of a response header became inclusive",
+	"en.descriptions.response-header-min-decreased":                         "The min value of a response header was decreased",
+	"en.descriptions.response-header-min-length-decreased":                  "The minLength of a response header was decreased",
+	"en.descriptions.response-header-min-properties-decreased":              "The minProperties of a response header was decreased",
+	"en.descriptions.response-header-min-unset":                             "The min value of a response header was removed",
+	"en.descriptions.response-header-multiple-of-changed":                   "The multipleOf of a response header was changed to a value which isn't compatible with the original one",
+	"en.descriptions.response-header-multiple-of-unset":                     "The multipleOf of a response header was unset",
+	"en.descriptions.response-header-pattern-added":                         "A pattern was added to a response header",
+	"en.descriptions.response-header-pattern-changed":                       "The pattern of a response header was changed",
+	"en.descriptions.response-header-pattern-removed":                       "The pattern of a response header was removed",
+	"en.descriptions.response-header-type-changed":                          "The type or format of a response header was changed",
+	"en.descriptions.response-header-unique-items-unset":                    "The uniqueItems of a response header was unset",
+	"en.descriptions.response-media-type-added":                             "A response media type was added",
+	"en.descriptions.response-media-type-removed":                           "A response media type was removed",
+	"en.descriptions.response-mediatype-enum-value-removed":                 "An enum value was removed from a response media type schema",
+	"en.descriptions.response-non-success-status-removed":                   "A non-success response status was removed",
+	"en.descriptions.response-optional-property-removed":                    "An optional response property was removed",
+	"en.descriptions.response-property-any-of-added":                        "A schema was added to the anyOf list of a response property",
+	"en.descriptions.response-property-any-of-removed":                      "A schema was removed from the anyOf list of a response property",
+	"en.descriptions.response-property-became-not-write-only":               "An optional response property is no longer write-only",
+	"en.descriptions.response-property-became-nullable":                     "A response property became nullable",
+	"en.descriptions.response-property-became-optional":                     "A required response property became optional",
+	"en.descriptions.response-property-became-write-only":                   "A response property became write-only",
+	"en.descriptions.response-property-discriminator-added":                 "A discriminator was added to a response property",
+	"en.descriptions.response-property-discriminator-mapping-added":         "A discriminator mapping value was added to a response property",
+	"en.descriptions.response-property-discriminator-mapping-changed":       "The schema of a discriminator mapping value of a response property was changed",
+	"en.descriptions.response-property-discriminator-mapping-removed":       "A discriminator mapping value was removed from a response property",
+	"en.descriptions.response-property-discriminator-property-name-changed": "The discriminator property name of a response property was changed",
+	"en.descriptions.response-property-discriminator-removed":               "The discriminator was removed from a response property",
+	"en.descriptions.response-property-enum-value-added":                    "An enum value was added to a response property",
+	"en.descriptions.response-property-enum-value-removed":                  "An enum value was removed from a response property",
+	"en.descriptions.response-property-max-became-inclusive":                "The maximum of a response property became inclusive",
+	"en.descriptions.response-property-max-increased":                       "The max value of a response property was increased",
+	"en.descriptions.response-property-max-items-increased":                 "The maxItems of a response property was increased",
+	"en.descriptions.response-property-max-items-unset":                     "The maxItems of a response property was unset",
+	"en.descriptions.response-property-max-length-increased":                "The maxLength of a response property was increased",
+	"en.descriptions.response-property-max-length-unset":                    "The maxLength of a response property was removed",
+	"en.descriptions.response-property-max-properties-increased":            "The maxProperties of a response property was increased",
+	"en.descriptions.response-property-max-properties-unset":                "The maxProperties of a response property was unset",
+	"en.descriptions.response-property-min-became-inclusive":                "The minimum of a response property became inclusive",
+	"en.descriptions.response-property-min-decreased":                       "The min value of a response property was decreased",
+	"en.descriptions.response-property-min-items-decreased":                 "The minItems of a response property was decreased",
+	"en.descriptions.response-property-min-items-unset":                     "The minItems of a response property was removed",
+	"en.descriptions.response-property-min-length-decreased":                "The minLength of a response property was decreased",
+	"en.descriptions.response-property-min-properties-decreased":            "The minProperties of a response property was decreased",
+	"en.descriptions.response-property-multiple-of-changed":                 "The multipleOf of a response property was changed to a value which isn't compatible with the original one",
+	"en.descriptions.response-property-multiple-of-unset":                   "The multipleOf of a response property was unset",
+	"en.descriptions.response-property-not-modified":                        "The not schema of a response property was modified",
+	"en.descriptions.response-property-one-of-added":                        "A schema was added to the oneOf list of a response property",
+	"en.descriptions.response-property-one-of-removed":                      "A schema was removed from the oneOf list of a response property",
+	"en.descriptions.response-property-type-changed":                        "The type or format of a response property was changed",
+	"en.descriptions.response-property-unique-items-unset":                  "The uniqueItems of a response property was unset",
+	"en.descriptions.response-required-property-became-not-write-only":      "A required response property is no longer write-only",
+	"en.descriptions.response-required-property-became-write-only":          "A required response property became write-only",
+	"en.descriptions.response-required-property-removed":                    "A required response property was removed",
+	"en.descriptions.response-schema-changed-partially":                     "The response schema was changed in some media types but not in others",
+	"en.descriptions.response-success-status-removed":                       "A success (2xx) response status was removed",
+	"en.descriptions.sunset-deleted":                                        "The sunset date of a deprecated endpoint was deleted",
+	"en.descriptions.unparseable-paramater-to-x-extensible-enum":            "The revised x-extensible-enum of a request parameter can't be parsed",
+	"en.descriptions.unparseable-parameter-from-x-extensible-enum":          "The original x-extensible-enum of a request parameter can't be parsed",
+	"en.descriptions.unparseable-property-from-x-extensible-enum":           "The original x-extensible-enum of a request property can't be parsed",
+	"en.descriptions.unparseable-property-to-x-extensible-enum":             "The revised x-extensible-enum of a request property can't be parsed",
+	"en.descriptions.webhook-added":                                         "A webhook was added",
+	"en.descriptions.webhook-operation-added":                               "An operation was added to a webhook",
+	"en.descriptions.webhook-operation-removed":                             "An operation was removed from a webhook",
+	"en.descriptions.webhook-removed":                                       "A webhook was removed",
+	"en.descriptions.webhook-request-required-property-added":               "A required property was added to the request body of a webhook",
+	"en.messages.added-required-request-body":                               "added required request body",
+	"en.messages.api-deprecated-sunset-parse":                               "api sunset date '%s' can't be parsed for deprecated API: %v",
+	"en.messages.api-global-security-added":                                 "added the new alternative global security requirement %s",
+	"en.messages.api-global-security-became-optional":                       "global security became optional",
+	"en.messages.api-global-security-became-required":                       "global security became required, endpoints without security requirements of their own now require %s",
+	"en.messages.api-global-security-removed":                               "removed the global security requirement %s",
+	"en.messages.api-global-security-scope-added":                           "added the required scope %s to the global security scheme %s",
+	"en.messages.api-global-security-scope-removed":                         "removed the scope %s from the global security scheme %s",
+	"en.messages.api-operation-endpoint-changed":                            "the endpoint of the operation %s was changed from %s to %s",
+	"en.messages.api-operation-id-removed":                                  "api operation id %s removed and replaced with %s",
+	"en.messages.api-path-removed-before-sunset":                            "api path removed before the sunset date %s",
+	"en.messages.api-path-removed-without-deprecation":                      "api path removed without deprecation",
+	"en.messages.api-removed-before-sunset":                                 "api removed before the sunset date %s",
+	"en.messages.api-removed-without-deprecation":                           "api removed without deprecation",
+	"en.messages.api-schema-removed":                                        "removed the schema %s from openapi components",
+	"en.messages.api-security-added":                                        "added the new alternative security requirement %s",
+	"en.messages.api-security-became-optional":                              "security became optional for the endpoint",
+	"en.messages.api-security-became-required":                              "security became required, the endpoint now requires %s",
+	"en.messages.api-security-component-added":                              "added the new security scheme %s to openapi components",
+	"en.messages.api-security-component-bearer-format-changed":              "changed the bearer format of the security scheme %s from %s to %s",
+	"en.messages.api-security-component-in-changed":                         "changed the api key location (in) of the security scheme %s from %s to %s",
+	"en.messages.api-security-component-name-changed":                       "changed the api key name of the security scheme %s from %s to %s",
+	"en.messages.api-security-component-oauth-flow-added":                   "added the oauth flow %s to the security scheme %s",
+	"en.messages.api-security-component-oauth-flow-removed":                 "removed the oauth flow %s from the security scheme %s",
+	"en.messages.api-security-component-oauth-scope-added":                  "added the scope %s to the oauth flow %s of the security scheme %s",
+	"en.messages.api-security-component-oauth-scope-removed":                "removed the scope %s from the oauth flow %s of the security scheme %s",
+	"en.messages.api-security-component-oauth-url-changed":                  "changed the %s of the oauth flow %s of the security scheme %s from %s to %s",
+	"en.messages.api-security-component-openid-url-changed":                 "changed the openid connect url of the security scheme %s from %s to %s",
+	"en.messages.api-security-component-removed":                            "removed the security scheme %s from openapi components",
+	"en.messages.api-security-component-scheme-changed":                     "changed the http scheme of the security scheme %s from %s to %s",
+	"en.messages.api-security-component-type-changed":                       "changed the type of the security scheme %s from %s to %s",
+	"en.messages.api-security-removed":                                      "removed the security requirement %s",
+	"en.messages.api-security-scope-added":                                  "added the required scope %s to the security scheme %s",
+	"en.messages.api-security-scope-removed":                                "removed the scope %s from the security scheme %s",
+	"en.messages.api-sunset-date-changed-too-small":                         "api sunset date changed to earlier date from %s to %s, new sunset date must be not earlier than %s at least %d days from now",
+	"en.messages.api-sunset-date-too-small":                                 "api sunset date '%s' is too small, must be at least %d days from now",
+	"en.messages.api-tag-removed":                                           "api tag %s removed",
+	"en.messages.at":                                                        "at",
+	"en.messages.callback-added":                                            "added the callback %s",
+	"en.messages.callback-operation-added":                                  "added the operation %s to the callback %s",
+	"en.messages.callback-operation-removed":                                "removed the operation %s from the callback %s, subscribers will no longer receive it",
+	"en.messages.callback-path-added":                                       "added the expression %s to the callback %s",
+	"en.messages.callback-path-removed":                                     "removed the expression %s from the callback %s, subscribers will no longer receive it",
+	"en.messages.callback-removed":                                          "removed the callback %s, subscribers will no longer receive it",
+	"en.messages.callback-request-changed":                                  "in the request of the callback %s %s, which is sent to subscribers: %s",
+	"en.messages.callback-request-status":                                   "callback request",
+	"en.messages.callback-response-changed":                                 "in the response with the status %s of the callback %s %s, which is sent by subscribers and checked like a request: %s",
+	"en.messages.changelog-additions":                                       "Additions",
+	"en.messages.changelog-breaking-changes":                                "Breaking Changes",
+	"en.messages.changelog-deprecated":                                      "deprecated",
+	"en.messages.changelog-deprecations":                                    "Deprecations",
+	"en.messages.changelog-no-changes":                                      "No changes",
+	"en.messages.changelog-other-changes":                                   "Other Changes",
+	"en.messages.changelog-sunset":                                          "sunset date %s",
+	"en.messages.changelog-title":                                           "API Changelog",
+	"en.messages.changelog-warnings":                                        "Potential Breaking Changes",
+	"en.messages.endpoint-added":                                            "endpoint added",
+	"en.messages.endpoint-deprecated":                                       "endpoint deprecated",
+	"en.messages.endpoint-reactivated":                                      "endpoint reactivated",
+	"en.messages.in":                                                        "in",
+	"en.messages.new-optional-request-parameter":                            "added the new optional %s request parameter %s",
+	"en.messages.new-request-path-parameter":                                "added the new path request parameter %s",
+	"en.messages.new-required-request-header-property":                      "added the new required %s request header's property %s",
+	"en.messages.new-required-request-parameter":                            "added the new required %s request parameter %s",
+	"en.messages.new-required-request-property":                             "added the new required request property %s",
+	"en.messages.not-modified-comment":                                      "It is a warning because a change inside not has the opposite effect of the same change outside of it, so it isn't checked like other changes",
+	"en.messages.optional-response-header-removed":                          "the optional response header %s removed for the status %s",
+	"en.messages.pattern-changed-warn-comment":                              "This is a warning because it is difficult to automatically analyze if the new pattern is a superset of the previous pattern(e.g. changed from '[0-9]+' to '[0-9]*')",
+	"en.messages.request-allOf-modified":                                    "modified allOf for the request property %s",
+	"en.messages.request-allOf-modified-comment":                            "It is a warning because it is very difficult to check that allOf changed correctly without breaking changes",
+	"en.messages.request-body-additional-properties-disallowed":             "the request body no longer allows additional properties",
+	"en.messages.request-body-any-of-added":                                 "added %s to the 'anyOf' list of the request body",
+	"en.messages.request-body-any-of-removed":                               "removed %s from the 'anyOf' list of the request body",
+	"en.messages.request-body-became-enum":                                  "request body was restricted to a list of enum values",
+	"en.messages.request-body-became-not-nullable":                          "the request's body became not nullable",
+	"en.messages.request-body-became-required":                              "request body became required",
+	"en.messages.request-body-default-value-added":                          "the %s request body default value %s was added",
+	"en.messages.request-body-default-value-changed":                        "the %s request body default value was changed from %s to %s",
+	"en.messages.request-body-default-value-removed":                        "the %s request body default value %s was removed",
+	"en.messages.request-body-discriminator-added":                          "added a discriminator to the request body",
+	"en.messages.request-body-discriminator-mapping-added":                  "added the discriminator mapping value %s to the request body",
+	"en.messages.request-body-discriminator-mapping-changed":                "changed the discriminator mapping value %s from %s to %s in the request body",
+	"en.messages.request-body-discriminator-mapping-removed":                "removed the discriminator mapping value %s from the request body",
+	"en.messages.request-body-discriminator-property-name-changed":          "changed the discriminator property name from %s to %s in the request body",
+	"en.messages.request-body-discriminator-removed":                        "removed the discriminator from the request body",
+	"en.messages.request-body-enum-value-removed":                           "request body enum value removed %s",
+	"en.messages.request-body-max-became-exclusive":                         "the request's body maximum %s became exclusive",
+	"en.messages.request-body-max-decreased":                                "the request's body max was decreased to %s",
+	"en.messages.request-body-max-items-decreased":                          "the request's body maxItems was decreased from %s to %s",
+	"en.messages.request-body-max-items-set":                                "the request's body maxItems was set to %s",
+	"en.messages.request-body-max-items-set-comment":                        "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-max-length-decreased":                         "the request's body maxLength was decreased to %s",
+	"en.messages.request-body-max-length-set":                               "the request's body maxLength was set to %s",
+	"en.messages.request-body-max-length-set-comment":                       "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-max-properties-decreased":                     "the request's body maxProperties was decreased from %s to %s",
+	"en.messages.request-body-max-properties-set":                           "the request's body maxProperties was set to %s",
+	"en.messages.request-body-max-properties-set-comment":                   "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-max-set":                                      "the request's body max was set to %s",
+	"en.messages.request-body-max-set-comment":                              "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-media-type-added":                             "added the request body media type %s",
+	"en.messages.request-body-media-type-removed":                           "removed the request body media type %s",
+	"en.messages.request-body-min-became-exclusive":                         "the request's body minimum %s became exclusive",
+	"en.messages.request-body-min-increased":                                "the request's body min was increased to %s",
+	"en.messages.request-body-min-items-increased":                          "the request's body minItems was increased to %s",
+	"en.messages.request-body-min-items-set":                                "the request's body minItems was set to %s",
+	"en.messages.request-body-min-items-set-comment":                        "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-min-properties-increased":                     "the request's body minProperties was increased from %s to %s",
+	"en.messages.request-body-min-set":                                      "the request's body min was set to %s",
+	"en.messages.request-body-min-set-comment":                              "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-multiple-of-changed":                          "the request's body multipleOf was changed from %s to %s",
+	"en.messages.request-body-multiple-of-set":                              "the request's body multipleOf was set to %s",
+	"en.messages.request-body-not-modified":                                 "modified the not schema of the request body",
+	"en.messages.request-body-one-of-added":                                 "added %s to the 'oneOf' list of the request body",
+	"en.messages.request-body-one-of-removed":                               "removed %s from the 'oneOf' list of the request body",
+	"en.messages.request-body-schema-changed-partially":                     "the request body schema was changed in the media types %s but not in %s",
+	"en.messages.request-body-type-changed":                                 "the request's body type/format changed from %s/%s to %s/%s",
+	"en.messages.request-body-unique-items-set":                             "the request's body uniqueItems was set",
+	"en.messages.request-header-property-became-enum":                       "the %s request header's property %s was restricted to a list of enum values",
+	"en.messages.request-header-property-became-required":                   "the %s request header's property %s became required",
+	"en.messages.request-header-property-default-value-added":               "for the %s request header's property %s, default value %s was added",
+	"en.messages.request-header-property-default-value-changed":             "for the %s request header's property %s, default value was changed from %s to %s",
+	"en.messages.request-header-property-default-value-removed":             "for the %s request header's property %s, default value %s was removed",
+	"en.messages.request-parameter-allow-reserved-disabled":                 "the %s request parameter %s no longer allows reserved characters",
+	"en.messages.request-parameter-became-enum":                             "the %s request parameter %s was restricted to a list of enum values",
+	"en.messages.request-parameter-became-optional":                         "the %s request parameter %s became optional",
+	"en.messages.request-parameter-became-required":                         "the %s request parameter %s became required",
+	"en.messages.request-parameter-default-value-changed":                   "for the %s request parameter %s, default value was changed from %s to %s",
+	"en.messages.request-parameter-enum-value-removed":                      "removed the enum value %s for the %s request parameter %s",
+	"en.messages.request-parameter-explode-changed":                         "for the %s request parameter %s, explode was changed from %s to %s",
+	"en.messages.request-parameter-max-became-exclusive":                    "for the %s request parameter %s, the maximum %s became exclusive",
+	"en.messages.request-parameter-max-decreased":                           "for the %s request parameter %s, the max was decreased from %s to %s",
+	"en.messages.request-parameter-max-items-decreased":                     "for the %s request parameter %s, the maxItems was decreased from %s to %s",
+	"en.messages.request-parameter-max-items-set":                           "for the %s request parameter %s, the maxItems was set to %s",
+	"en.messages.request-parameter-max-items-set-comment":                   "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-max-length-decreased":                    "for the %s request parameter %s, the maxLength was decreased from %s to %s",
+	"en.messages.request-parameter-max-length-set":                          "for the %s request parameter %s, the maxLength was set to %s",
+	"en.messages.request-parameter-max-length-set-comment":                  "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-max-properties-decreased":                "for the %s request parameter %s, the maxProperties was decreased from %s to %s",
+	"en.messages.request-parameter-max-properties-set":                      "for the %s request parameter %s, the maxProperties was set to %s",
+	"en.messages.request-parameter-max-properties-set-comment":              "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-max-set":                                 "for the %s request parameter %s, the max was set to %s",
+	"en.messages.request-parameter-max-set-comment":                         "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-min-became-exclusive":                    "for the %s request parameter %s, the minimum %s became exclusive",
+	"en.messages.request-parameter-min-increased":                           "for the %s request parameter %s, the min was increased from %s to %s",
+	"en.messages.request-parameter-min-items-increased":                     "for the %s request parameter %s, the minItems was increased from %s to %s",
+	"en.messages.request-parameter-min-items-set":                           "for the %s request parameter %s, the minItems was set to %s",
+	"en.messages.request-parameter-min-items-set-comment":                   "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-min-properties-increased":                "for the %s request parameter %s, the minProperties was increased from %s to %s",
+	"en.messages.request-parameter-min-set":                                 "for the %s request parameter %s, the min was set to %s",
+	"en.messages.request-parameter-min-set-comment":                         "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-moved":                                   "moved the %s request parameter %s to the %s request parameter %s",
+	"en.messages.request-parameter-multiple-of-changed":                     "for the %s request parameter %s, the multipleOf was changed from %s to %s",
+	"en.messages.request-parameter-multiple-of-set":                         "for the %s request parameter %s, the multipleOf was set to %s",
+	"en.messages.request-parameter-pattern-added":                           "added the pattern '%s' for the %s request parameter %s",
+	"en.messages.request-parameter-pattern-changed":                         "changed the pattern for the %s request parameter %s from '%s' to '%s'",
+	"en.messages.request-parameter-removed":                                 "deleted the %s request parameter %s",
+	"en.messages.request-parameter-renamed":                                 "renamed the %s request parameter %s to %s",
+	"en.messages.request-parameter-style-changed":                           "for the %s request parameter %s, the style was changed from %s to %s",
+	"en.messages.request-parameter-type-changed":                            "for the %s request parameter %s, the type/format was changed from %s/%s to %s/%s",
+	"en.messages.request-parameter-unique-items-set":                        "for the %s request parameter %s, the uniqueItems was set",
+	"en.messages.request-parameter-x-extensible-enum-value-removed":         "removed the x-extensible-enum value %s for the %s request parameter %s",
+	"en.messages.request-property-additional-properties-disallowed":         "the request property %s no longer allows additional properties",
+	"en.messages.request-property-any-of-added":                             "added %s to the 'anyOf' list of the request property %s",
+	"en.messages.request-property-any-of-removed":                           "removed %s from the 'anyOf' list of the request property %s",
+	"en.messages.request-property-became-enum":                              "request property %s was restricted to a list of enum values",
+	"en.messages.request-property-became-not-nullable":                      "the request property %s became not nullable",
+	"en.messages.request-property-became-not-read-only":                     "the request property %s became not read-only",
+	"en.messages.request-property-became-read-only":                         "the request property %s became read-only",
+	"en.messages.request-property-became-required":                          "the request property %s became required",
+	"en.messages.request-property-default-value-added":                      "for the request property %s, default value %s was added",
+	"en.messages.request-property-default-value-changed":                    "for the request property %s, default value was changed from %s to %s",
+	"en.messages.request-property-default-value-removed":                    "for the request property %s, default value %s was removed",
+	"en.messages.request-property-discriminator-added":                      "added a discriminator to the request property %s",
+	"en.messages.request-property-discriminator-mapping-added":              "added the discriminator mapping value %s to the request property %s",
+	"en.messages.request-property-discriminator-mapping-changed":            "changed the discriminator mapping value %s from %s to %s in the request property %s",
+	"en.messages.request-property-discriminator-mapping-removed":            "removed the discriminator mapping value %s from the request property %s",
+	"en.messages.request-property-discriminator-property-name-changed":      "changed the discriminator property name from %s to %s in the request property %s",
+	"en.messages.request-property-discriminator-removed":                    "removed the discriminator from the request property %s",
+	"en.messages.request-property-enum-value-removed":                       "removed the enum value %s of the request property %s",
+	"en.messages.request-property-max-became-exclusive":                     "the maximum %s became exclusive in the request property %s",
+	"en.messages.request-property-max-decreased":                            "the %s request property's max was decreased to %s",
+	"en.messages.request-property-max-items-decreased":                      "the maxItems was decreased from %s to %s in the request property %s",
+	"en.messages.request-property-max-items-set":                            "the maxItems was set to %s in the request property %s",
+	"en.messages.request-property-max-items-set-comment":                    "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-max-length-decreased":                     "the %s request property's maxLength was decreased to %s",
+	"en.messages.request-property-max-length-set":                           "the %s request property's maxLength was set to %s",
+	"en.messages.request-property-max-length-set-comment":                   "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-max-properties-decreased":                 "the maxProperties was decreased from %s to %s in the request property %s",
+	"en.messages.request-property-max-properties-set":                       "the maxProperties was set to %s in the request property %s",
+	"en.messages.request-property-max-properties-set-comment":               "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-max-set":                                  "the %s request property's max was set to %s",
+	"en.messages.request-property-max-set-comment":                          "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-min-became-exclusive":                     "the minimum %s became exclusive in the request property %s",
+	"en.messages.request-property-min-increased":                            "the %s request property's min was increased to %s",
+	"en.messages.request-property-min-items-increased":                      "the %s request property's minItems was increased to %s",
+	"en.messages.request-property-min-items-set":                            "the %s request property's minItems was set to %s",
+	"en.messages.request-property-min-items-set-comment":                    "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-min-properties-increased":                 "the minProperties was increased from %s to %s in the request property %s",
+	"en.messages.request-property-min-set":                                  "the %s request property's min was set to %s",
+	"en.messages.request-property-min-set-comment":                          "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-multiple-of-changed":                      "the multipleOf was changed from %s to %s in the request property %s",
+	"en.messages.request-property-multiple-of-set":                          "the multipleOf was set to %s in the request property %s",
+	"en.messages.request-property-not-modified":                             "modified the not schema of the request property %s",
+	"en.messages.request-property-one-of-added":                             "added %s to the 'oneOf' list of the request property %s",
+	"en.messages.request-property-one-of-removed":                           "removed %s from the 'oneOf' list of the request property %s",
+	"en.messages.request-property-pattern-added":                            "added the pattern '%s' for the request property %s",
+	"en.messages.request-property-pattern-changed":                          "changed the pattern for the request property %s from '%s' to '%s'",
+	"en.messages.request-property-removed":                                  "removed the request property %s",
+	"en.messages.request-property-type-changed":                             "the %s request property type/format changed from %s/%s to %s/%s",
+	"en.messages.request-property-unique-items-set":                         "the uniqueItems was set in the request property %s",
+	"en.messages.request-property-x-extensible-enum-value-removed":          "removed the x-extensible-enum value '%s' of the request property %s",
+	"en.messages.request-required-property-became-not-read-only":            "the required request property %s became not read-only",
+	"en.messages.request-required-property-became-read-only":                "the required request property %s became read-only",
+	"en.messages.required-response-header-removed":                          "the mandatory response header %s removed for the status %s",
+	"en.messages.response-body-any-of-added":                                "added %s to the 'anyOf' list of the response body for the response status %s",
+	"en.messages.response-body-any-of-removed":                              "removed %s from the 'anyOf' list of the response body for the response status %s",
+	"en.messages.response-body-became-nullable":                             "the response's body became nullable",
+	"en.messages.response-body-discriminator-added":                         "added a discriminator to the response body for the response status %s",
+	"en.messages.response-body-discriminator-mapping-added":                 "added the discriminator mapping value %s to the response body for the response status %s",
+	"en.messages.response-body-discriminator-mapping-changed":               "changed the discriminator mapping value %s from %s to %s in the response body for the response status %s",
+	"en.messages.response-body-discriminator-mapping-removed":               "removed the discriminator mapping value %s from the response body for the response status %s",
+	"en.messages.response-body-discriminator-property-name-changed":         "changed the discriminator property name from %s to %s in the response body for the response status %s",
+	"en.messages.response-body-discriminator-removed":                       "removed the discriminator from the response body for the response status %s",
+	"en.messages.response-body-max-became-inclusive":                        "the response's body maximum %s became inclusive for the response status %s",
+	"en.messages.response-body-max-increased":                               "the response's body max was increased from %s to %s",
+	"en.messages.response-body-max-items-increased":                         "the response's body maxItems was increased from %s to %s for the response status %s",
+	"en.messages.response-body-max-items-unset":                             "the response's body maxItems was unset from %s for the response status %s",
+	"en.messages.response-body-max-length-increased":                        "the response's body maxLength was increased from %s to %s",
+	"en.messages.response-body-max-length-unset":                            "the response's body maxLength was unset from %s",
+	"en.messages.response-body-max-properties-increased":                    "the response's body maxProperties was increased from %s to %s for the response status %s",
+	"en.messages.response-body-max-properties-unset":                        "the response's body maxProperties was unset from %s for the response status %s",
+	"en.messages.response-body-min-became-inclusive":                        "the response's body minimum %s became inclusive for the response status %s",
+	"en.messages.response-body-min-decreased":                               "the response's body min was decreased from %s to %s",
+	"en.messages.response-body-min-items-decreased":                         "the response's body minItems was decreased from %s to %s",
+	"en.messages.response-body-min-items-unset":                             "the response's body minItems was unset from %s",
+	"en.messages.response-body-min-length-decreased":                        "the response's body minLength was decreased from %s to %s",
+	"en.messages.response-body-min-properties-decreased":                    "the response's body minProperties was decreased from %s to %s for the response status %s",
+	"en.messages.response-body-multiple-of-changed":                         "the response's body multipleOf was changed from %s to %s for the response status %s",
+	"en.messages.response-body-multiple-of-unset":                           "the response's body multipleOf was unset from %s for the response status %s",
+	"en.messages.response-body-not-modified":                                "modified the not schema of the response body for the response status %s",
+	"en.messages.response-body-one-of-added":                                "added %s to the 'oneOf' list of the response body for the response status %s",
+	"en.messages.response-body-one-of-removed":                              "removed %s from the 'oneOf' list of the response body for the response status %s",
+	"en.messages.response-body-type-changed":                                "the response's body type/format changed from %s/%s to %s/%s for status %s",
+	"en.messages.response-body-unique-items-unset":                          "the response's body uniqueItems was unset for the response status %s",
+	"en.messages.response-header-became-optional":                           "the response header %s became optional for the status %s",
+	"en.messages.response-header-enum-value-added":                          "added the new %s enum value to the response header %s for the response status %s",
+	"en.messages.response-header-enum-value-added-comment":                  "Adding new enum values to response headers could be unexpected for clients, use x-extensible-enum instead.",
+	"en.messages.response-header-enum-value-removed":                        "removed the %s enum value from the response header %s for the response status %s",
+	"en.messages.response-header-max-became-inclusive":                      "the response header %s maximum %s became inclusive for the response status %s",
+	"en.messages.response-header-max-increased":                             "the response header %s max was increased from %s to %s for the response status %s",
+	"en.messages.response-header-max-items-increased":                       "the response header %s maxItems was increased from %s to %s for the response status %s",
+	"en.messages.response-header-max-items-unset":                           "the response header %s maxItems was unset from %s for the response status %s",
+	"en.messages.response-header-max-length-increased":                      "the response header %s maxLength was increased from %s to %s for the response status %s",
+	"en.messages.response-header-max-length-unset":                          "the response header %s maxLength was unset from %s for the response status %s",
+	"en.messages.response-header-max-properties-increased":                  "the response header %s maxProperties was increased from %s to %s for the response status %s",
+	"en.messages.response-header-max-properties-unset":                      "the response header %s maxProperties was unset from %s for the response status %s",
+	"en.messages.response-header-max-unset":                                 "the response header %s max was unset from %s for the response status %s",
+	"en.messages.response-header-min-became-inclusive":                      "the response header %s minimum %s became inclusive for the response status %s",
+	"en.messages.response-header-min-decreased":                             "the response header %s min was decreased from %s to %s for the response status %s",
+	"en.messages.response-header-min-length-decreased":                      "the response header %s minLength was decreased from %s to %s for the response status %s",
+	"en.messages.response-header-min-properties-decreased":                  "the response header %s minProperties was decreased from %s to %s for the response status %s",
+	"en.messages.response-header-min-unset":                                 "the response header %s min was unset from %s for the response status %s",
+	"en.messages.response-header-multiple-of-changed":                       "the response header %s multipleOf was changed from %s to %s for the response status %s",
+	"en.messages.response-header-multiple-of-unset":                         "the response header %s multipleOf was unset from %s for the response status %s",
+	"en.messages.response-header-pattern-added":                             "added the pattern %s to the response header %s for the response status %s",
+	"en.messages.response-header-pattern-changed":                           "changed the pattern of the response header %s from %s to %s for the response status %s",
+	"en.messages.response-header-pattern-removed":                           "removed the pattern %s from the response header %s for the response status %s",
+	"en.messages.response-header-type-changed":                              "the response header %s type/format changed from %s/%s to %s/%s for the response status %s",
+	"en.messages.response-header-unique-items-unset":                        "the response header %s uniqueItems was unset for the response status %s",
+	"en.messages.response-media-type-added":                                 "added the media type %s for the response with the status %s",
+	"en.messages.response-media-type-removed":                               "removed the media type %s for the response with the status %s",
+	"en.messages.response-mediatype-enum-value-removed":                     "response schema %s enum value removed %s",
+	"en.messages.response-non-success-status-removed":                       "removed the non-success response with the status %s",
+	"en.messages.response-optional-property-removed":                        "removed the optional property %s from the response with the %s status",
+	"en.messages.response-property-any-of-added":                            "added %s to the 'anyOf' list of the response property %s for the response status %s",
+	"en.messages.response-property-any-of-removed":                          "removed %s from the 'anyOf' list of the response property %s for the response status %s",
+	"en.messages.response-property-became-not-write-only":                   "the response property %s became not write-only for the status %s",
+	"en.messages.response-property-became-nullable":                         "the response property %s became nullable for the status %s",
+	"en.messages.response-property-became-optional":                         "the response property %s became optional for the status %s",
+	"en.messages.response-property-became-write-only":                       "the response property %s became write-only for the status %s",
+	"en.messages.response-property-discriminator-added":                     "added a discriminator to the response property %s for the response status %s",
+	"en.messages.response-property-discriminator-mapping-added":             "added the discriminator mapping value %s to the response property %s for the response status %s",
+	"en.messages.response-property-discriminator-mapping-changed":           "changed the discriminator mapping value %s from %s to %s in the response property %s for the response status %s",
+	"en.messages.response-property-discriminator-mapping-removed":           "removed the discriminator mapping value %s from the response property %s for the response status %s",
+	"en.messages.response-property-discriminator-property-name-changed":     "changed the discriminator property name from %s to %s in the response property %s for the response status %s",
+	"en.messages.response-property-discriminator-removed":                   "removed the discriminator from the response property %s for the response status %s",
+	"en.messages.response-property-enum-value-added":                        "added the new '%s' enum value the %s response property for the response status %s",
+	"en.messages.response-property-enum-value-added-comment":                "Adding new enum values to response could be unexpected for clients, use x-extensible-enum instead.",
+	"en.messages.response-property-enum-value-removed":                      "removed the '%s' enum value from the %s response property for the response status %s",
+	"en.messages.response-property-max-became-inclusive":                    "the maximum %s became inclusive in the response property %s for the response status %s",
+	"en.messages.response-property-max-increased":                           "the %s response property's max was increased from %s to %s for the response status %s",
+	"en.messages.response-property-max-items-increased":                     "the maxItems was increased from %s to %s in the response property %s for the response status %s",
+	"en.messages.response-property-max-items-unset":                         "the maxItems was unset from %s in the response property %s for the response status %s",
+	"en.messages.response-property-max-length-increased":                    "the %s response property's maxLength was increased from %s to %s for the response status %s",
+	"en.messages.response-property-max-length-unset":                        "the %s response property's maxLength was unset from %s for the response status %s",
+	"en.messages.response-property-max-properties-increased":                "the maxProperties was increased from %s to %s in the response property %s for the response status %s",
+	"en.messages.response-property-max-properties-unset":                    "the maxProperties was unset from %s in the response property %s for the response status %s",
+	"en.messages.response-property-min-became-inclusive":                    "the minimum %s became inclusive in the response property %s for the response status %s",
+	"en.messages.response-property-min-decreased":                           "the %s response property's min was decreased from %s to %s for the response status %s",
+	"en.messages.response-property-min-items-decreased":                     "the %s response property's minItems was decreased from %s to %s for the response status %s",
+	"en.messages.response-property-min-items-unset":                         "the %s response property's minItems was unset from %s for the response status %s",
+	"en.messages.response-property-min-length-decreased":                    "the %s response property's minLength was decreased from %s to %s for the response status %s",
+	"en.messages.response-property-min-properties-decreased":                "the minProperties was decreased from %s to %s in the response property %s for the response status %s",
+	"en.messages.response-property-multiple-of-changed":                     "the multipleOf was changed from %s to %s in the response property %s for the response status %s",
+	"en.messages.response-property-multiple-of-unset":                       "the multipleOf was unset from %s in the response property %s for the response status %s",
+	"en.messages.response-property-not-modified":                            "modified the not schema of the response property %s for the response status %s",
+	"en.messages.response-property-one-of-added":                            "added %s to the 'oneOf' list of the response property %s for the response status %s",
+	"en.messages.response-property-one-of-removed":                          "removed %s from the 'oneOf' list of the response property %s for the response status %s",
+	"en.messages.response-property-type-changed":                            "the response's property type/format changed from %s/%s to %s/%s for status %s",
+	"en.messages.response-property-unique-items-unset":                      "the uniqueItems was unset in the response property %s for the response status %s",
+	"en.messages.response-required-property-became-not-write-only":          "the response required property %s became not write-only for the status %s",
+	"en.messages.response-required-property-became-not-write-only-comment":  "It is valid only if the property was always returned before the specification has been changed",
+	"en.messages.response-required-property-became-write-only":              "the response required property %s became write-only for the status %s",
+	"en.messages.response-required-property-removed":                        "removed the required property %s from the response with the %s status",
+	"en.messages.response-schema-changed-partially":                         "the schema of the response with the status %s was changed in the media types %s but not in %s",
+	"en.messages.response-success-status-removed":                           "removed the success response with the status %s",
+	"en.messages.sunset-deleted":                                            "api sunset date deleted, but deprecated=true kept",
+	"en.messages.total-errors":                                              "Backward compatibility errors (%d):\n",
+	"en.messages.webhook-added":                                             "added the webhook %s",
+	"en.messages.webhook-operation-added":                                   "added the operation %s to the webhook %s",
+	"en.messages.webhook-operation-removed":                                 "removed the operation %s from the webhook %s",
+	"en.messages.webhook-removed":                                           "removed the webhook %s, subscribers will no longer receive it",
+	"en.messages.webhook-request-changed":                                   "in the request of the webhook %s, which is sent to subscribers: %s",
+	"en.messages.webhook-request-status":                                    "webhook request",
+	"en.messages.webhook-response-changed":                                  "in the response with the status %s of the webhook %s, which is sent by subscribers and checked like a request: %s",
+	"ru.messages.added-required-request-body":                               "добавлено обязательное тело запроса",
+	"ru.messages.api-deprecated-sunset-parse":                               "API deprecated без валидно парсящейся '%s' даты sunset: %v",
+	"ru.messages.api-global-security-added":                                 "добавлено новое альтернативное глобальное требование безопасности %s",
+	"ru.messages.api-global-security-became-optional":                       "глобальная безопасность стала необязательной",
+	"ru.messages.api-global-security-became-required":                       "глобальная безопасность стала обязательной, эндпоинты без собственных требований безопасности теперь требуют %s",
+	"ru.messages.api-global-security-removed":                               "удалено глобальное требование безопасности %s",
+	"ru.messages.api-global-security-scope-added":                           "добавлен обязательный scope %s в глобальную схему безопасности %s",
+	"ru.messages.api-global-security-scope-removed":                         "удалён scope %s из глобальной схемы безопасности %s",
+	"ru.messages.api-operation-endpoint-changed":                            "эндпоинт операции %s изменён с %s на %s",
+	"ru.messages.api-operation-id-removed":                                  "Идентификатор операции API %s удален и заменен на %s",
+	"ru.messages.api-path-added":                                            "API path добавлено",
+	"ru.messages.api-path-deprecated":                                       "API path deprecated",
+	"ru.messages.api-path-reactivated":                                      "API path реактивирован",
+	"ru.messages.api-path-removed-before-sunset":                            "API path удалён до даты sunset %s",
+	"ru.messages.api-path-removed-without-deprecation":                      "api path удалён без процедуры deprecation",
+	"ru.messages.api-removed-before-sunset":                                 "API удалёг до даты sunset %s",
+	"ru.messages.api-removed-without-deprecation":                           "API удалён без deprecation",
+	"ru.messages.api-schema-removed":                                        "удалена схема %s из компонентов openapi",
+	"ru.messages.api-security-added":                                        "добавлено новое альтернативное требование безопасности %s",
+	"ru.messages.api-security-became-optional":                              "безопасность эндпоинта стала необязательной",
+	"ru.messages.api-security-became-required":                              "безопасность стала обязательной, теперь эндпоинт требует %s",
+	"ru.messages.api-security-component-added":                              "добавлена новая схема безопасности %s в компоненты openapi",
+	"ru.messages.api-security-component-bearer-format-changed":              "формат bearer схемы безопасности %s изменён с %s на %s",
+	"ru.messages.api-security-component-in-changed":                         "расположение (in) api key схемы безопасности %s изменено с %s на %s",
+	"ru.messages.api-security-component-name-changed":                       "имя api key схемы безопасности %s изменено с %s на %s",
+	"ru.messages.api-security-component-oauth-flow-added":                   "добавлен oauth flow %s в схему безопасности %s",
+	"ru.messages.api-security-component-oauth-flow-removed":                 "удалён oauth flow %s из схемы безопасности %s",
+	"ru.messages.api-security-component-oauth-scope-added":                  "добавлен scope %s в oauth flow %s схемы безопасности %s",
+	"ru.messages.api-security-component-oauth-scope-removed":                "удалён scope %s из oauth flow %s схемы безопасности %s",
+	"ru.messages.api-security-component-oauth-url-changed":                  "изменён %s oauth flow %s схемы безопасности %s с %s на %s",
+	"ru.messages.api-security-component-openid-url-changed":                 "openid connect url схемы безопасности %s изменён с %s на %s",
+	"ru.messages.api-security-component-removed":                            "удалена схема безопасности %s из компонентов openapi",
+	"ru.messages.api-security-component-scheme-changed":                     "http схема схемы безопасности %s изменена с %s на %s",
+	"ru.messages.api-security-component-type-changed":                       "тип схемы безопасности %s изменён с %s на %s",
+	"ru.messages.api-security-removed":                                      "удалено требование безопасности %s",
+	"ru.messages.api-security-scope-added":                                  "добавлен обязательный scope %s в схему безопасности %s",
+	"ru.messages.api-security-scope-removed":                                "удалён scope %s из схемы безопасности %s",
+	"ru.messages.api-sunset-date-changed-too-small":                         "дата sunset у API изменена на более раннюю с %s на %s, новая дата sunset должна быть либо не раньше %s, либо, как минимум, %d дней от текущего дня",
+	"ru.messages.api-sunset-date-too-small":                                 "дата API sunset date '%s' слишком ранняя, должно быть как минимум %d дней от текущего дня",
+	"ru.messages.api-tag-removed":                                           "Тег API %s удален",
+	"ru.messages.at":                                                        "в",
+	"ru.messages.callback-added":                                            "добавлен обратный вызов %s",
+	"ru.messages.callback-operation-added":                                  "добавлена операция %s в обратный вызов %s",
+	"ru.messages.callback-operation-removed":                                "удалена операция %s из обратного вызова %s, подписчики больше не будут ее получать",
+	"ru.messages.callback-path-added":                                       "добавлено выражение %s в обратный вызов %s",
+	"ru.messages.callback-path-removed":                                     "удалено выражение %s из обратного вызова %s, подписчики больше не будут его получать",
+	"ru.messages.callback-removed":                                          "удален обратный вызов %s, подписчики больше не будут его получать",
+	"ru.messages.callback-request-changed":                                  "в запросе обратного вызова %s %s, который отправляется подписчикам: %s",
+	"ru.messages.callback-request-status":                                   "запрос обратного вызова",
+	"ru.messages.callback-response-changed":                                 "в ответе со статусом %s обратного вызова %s %s, который отправляется подписчиками и проверяется как запрос: %s",
+	"ru.messages.changelog-additions":                                       "Добавления",
+	"ru.messages.changelog-breaking-changes":                                "Критические изменения",
+	"ru.messages.changelog-deprecated":                                      "устарел",
+	"ru.messages.changelog-deprecations":                                    "Устаревшие элементы",
+	"ru.messages.changelog-no-changes":                                      "Нет изменений",
+	"ru.messages.changelog-other-changes":                                   "Другие изменения",
+	"ru.messages.changelog-sunset":                                          "дата sunset %s",
+	"ru.messages.changelog-title":                                           "Журнал изменений API",
+	"ru.messages.changelog-warnings":                                        "Возможные критические изменения",
+	"ru.messages.in":                                                        "в",
+	"ru.messages.new-optional-request-parameter":                            "добавлен новый необязательный %s параметр зароса %s",
+	"ru.messages.new-request-path-parameter":                                "добален новый path параметр запроса %s",
+	"ru.messages.new-required-request-header-property":                      "в заголовке запроса %s добавлено новое обязательное поле %s",
+	"ru.messages.new-required-request-parameter":                            "добавлен новый обязательный %s параметр зароса %s",
+	"ru.messages.new-required-request-property":                             "добавлено новле обязательное поле запроса %s",
+	"ru.messages.not-modified-comment":                                      "Это предупреждение, потому что изменение внутри not имеет обратный эффект по сравнению с тем же изменением вне его, поэтому оно не проверяется как другие изменения",
+	"ru.messages.optional-response-header-removed":                          "удалён ранее необязательный заголовок ответа %s для ответа со статусом %s",
+	"ru.messages.pattern-changed-warn-comment":                              "Это предупреждение, потому что сложно автоматически проанализировать, является ли новый шаблон надмножеством предыдущего шаблона (например, изменен с '[0-9]+' на '[0-9]*').",
+	"ru.messages.request-allOf-modified":                                    "изменено allOf для поля запроса %s",
+	"ru.messages.request-allOf-modified-comment":                            "Это предупреждение, потому что очень сложно алгоритмически автоматизированно проверить правильность изменения allOf на обратную совместимость.",
+	"ru.messages.request-body-additional-properties-disallowed":             "тело запроса больше не допускает дополнительные свойства",
+	"ru.messages.request-body-any-of-added":                                 "добавлена схема %s в список 'anyOf' тела запроса",
+	"ru.messages.request-body-any-of-removed":                               "удалена схема %s из списка 'anyOf' тела запроса",
+	"ru.messages.request-body-became-enum":                                  "тело запроса было ограничено списком значений перечисления",
+	"ru.messages.request-body-became-not-nullable":                          "тело запроса стало недействительным",
+	"ru.messages.request-body-became-required":                              "тело запроса стало обязательным",
+	"ru.messages.request-body-default-value-added":                          "добавлено значение по умолчанию тела запроса %s %s",
+	"ru.messages.request-body-default-value-changed":                        "значение по умолчанию тела запроса %s изменено с %s на %s",
+	"ru.messages.request-body-default-value-removed":                        "удалено значение по умолчанию тела запроса %s %s",
+	"ru.messages.request-body-discriminator-added":                          "добавлен discriminator тела запроса",
+	"ru.messages.request-body-discriminator-mapping-added":                  "добавлено значение %s в mapping discriminator тела запроса",
+	"ru.messages.request-body-discriminator-mapping-changed":                "значение %s в mapping discriminator изменено с %s на %s для тела запроса",
+	"ru.messages.request-body-discriminator-mapping-removed":                "удалено значение %s из mapping discriminator тела запроса",
+	"ru.messages.request-body-discriminator-property-name-changed":          "имя свойства discriminator изменено с %s на %s для тела запроса",
+	"ru.messages.request-body-discriminator-removed":                        "удалён discriminator тела запроса",
+	"ru.messages.request-body-enum-value-removed":                           "значение перечисления тела запроса удалено %s",
+	"ru.messages.request-body-max-became-exclusive":                         "у тела запроса максимум %s стал исключающим",
+	"ru.messages.request-body-max-decreased":                                "значение max у тела запроса уменьшено до %s",
+	"ru.messages.request-body-max-items-decreased":                          "у тела запроса maxItems уменьшен с %s до %s",
+	"ru.messages.request-body-max-items-set":                                "у тела запроса maxItems установлен в %s",
+	"ru.messages.request-body-max-items-set-comment":                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-max-length-decreased":                         "значение maxLength у тела запроса уменьшено до %s",
+	"ru.messages.request-body-max-length-set":                               "у тела запроса задано значение maxLength в %s",
+	"ru.messages.request-body-max-length-set-comment":                       "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-max-properties-decreased":                     "у тела запроса maxProperties уменьшен с %s до %s",
+	"ru.messages.request-body-max-properties-set":                           "у тела запроса maxProperties установлен в %s",
+	"ru.messages.request-body-max-properties-set-comment":                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-max-set":                                      "у тела запроса задано значение max в %s",
+	"ru.messages.request-body-max-set-comment":                              "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-media-type-added":                             "добавлен media type %s тела запроса",
+	"ru.messages.request-body-media-type-removed":                           "удалён media type %s тела запроса",
+	"ru.messages.request-body-min-became-exclusive":                         "у тела запроса минимум %s стал исключающим",
+	"ru.messages.request-body-min-increased":                                "значение min у тела запроса увеличено до %s",
+	"ru.messages.request-body-min-items-increased":                          "значение minItems у тела запроса увеличено до %s",
+	"ru.messages.request-body-min-items-set":                                "задано значение minItems у тела запроса в %s",
+	"ru.messages.request-body-min-items-set-comment":                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-min-properties-increased":                     "у тела запроса minProperties увеличен с %s до %s",
+	"ru.messages.request-body-min-set":                                      "задано значение min у тела запроса в %s",
+	"ru.messages.request-body-min-set-comment":                              "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-multiple-of-changed":                          "у тела запроса multipleOf изменён с %s на %s",
+	"ru.messages.request-body-multiple-of-set":                              "у тела запроса multipleOf установлен в %s",
+	"ru.messages.request-body-not-modified":                                 "изменена схема not тела запроса",
+	"ru.messages.request-body-one-of-added":                                 "добавлена схема %s в список 'oneOf' тела запроса",
+	"ru.messages.request-body-one-of-removed":                               "удалена схема %s из списка 'oneOf' тела запроса",
+	"ru.messages.request-body-schema-changed-partially":                     "схема тела запроса изменена в media types %s, но не в %s",
+	"ru.messages.request-body-type-changed":                                 "изменился type/format тела запроса с %s/%s на %s/%s",
+	"ru.messages.request-body-unique-items-set":                             "у тела запроса uniqueItems установлен",
+	"ru.messages.request-header-property-became-enum":                       "свойство %s заголовка запроса %s было ограничено списком значений перечисления",
+	"ru.messages.request-header-property-became-required":                   "в заголовке запроса %s поле %s стало обязательным",
+	"ru.messages.request-header-property-default-value-added":               "в заголовке запроса %s у поля %s добавлено значение по умолчанию %s",
+	"ru.messages.request-header-property-default-value-changed":             "в заголовке запроса %s у поля %s значение по умолчанию изменено с %s на %s",
+	"ru.messages.request-header-property-default-value-removed":             "в заголовке запроса %s у поля %s удалено значение по умолчанию %s",
+	"ru.messages.request-parameter-allow-reserved-disabled":                 "в %s параметре запроса %s больше не допускаются зарезервированные символы",
+	"ru.messages.request-parameter-became-enum":                             "заголовок запроса %s поле %s было ограничено списком значений перечисления",
+	"ru.messages.request-parameter-became-optional":                         "ранее необязательный параметр запроса %s %s теперь является необязательным",
+	"ru.messages.request-parameter-became-required":                         "ранее необязательный %s параметр запроса %s стал обязательным",
+	"ru.messages.request-parameter-default-value-changed":                   "в %s параметре запроса %s, значение по умолчанию изменено с %s на %s",
+	"ru.messages.request-parameter-enum-value-removed":                      "удалено значение enum %s у %s параметра запроса %s",
+	"ru.messages.request-parameter-explode-changed":                         "в %s параметре запроса %s, explode изменился с %s на %s",
+	"ru.messages.request-parameter-max-became-exclusive":                    "в %s параметре запроса %s, максимум %s стал исключающим",
+	"ru.messages.request-parameter-max-decreased":                           "в %s параметре запроса %s, max уменьшен с %s до %s",
+	"ru.messages.request-parameter-max-items-decreased":                     "в %s параметре запроса %s, maxItems уменьшен с %s до %s",
+	"ru.messages.request-parameter-max-items-set":                           "в %s параметре запроса %s, maxItems установлен в %s",
+	"ru.messages.request-parameter-max-items-set-comment":                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-max-length-decreased":                    "в %s параметре запроса %s, maxLength уменьшен с %s до %s",
+	"ru.messages.request-parameter-max-length-set":                          "в %s параметре запроса %s, maxLength установлен в %s",
+	"ru.messages.request-parameter-max-length-set-comment":                  "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-max-properties-decreased":                "в %s параметре запроса %s, maxProperties уменьшен с %s до %s",
+	"ru.messages.request-parameter-max-properties-set":                      "в %s параметре запроса %s, maxProperties установлен в %s",
+	"ru.messages.request-parameter-max-properties-set-comment":              "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-max-set":                                 "в %s параметре запроса %s, max установлен в %s",
+	"ru.messages.request-parameter-max-set-comment":                         "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-min-became-exclusive":                    "в %s параметре запроса %s, минимум %s стал исключающим",
+	"ru.messages.request-parameter-min-increased":                           "в %s параметре запроса %s, min увеличен с %s до %s",
+	"ru.messages.request-parameter-min-items-increased":                     "в %s параметре запроса %s, minItems увеличен с %s до %s",
+	"ru.messages.request-parameter-min-items-set":                           "в %s параметре запроса %s, minItems установлен в %s",
+	"ru.messages.request-parameter-min-items-set-comment":                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-min-properties-increased":                "в %s параметре запроса %s, minProperties увеличен с %s до %s",
+	"ru.messages.request-parameter-min-set":                                 "в %s параметре запроса %s, min установлен в %s",
+	"ru.messages.request-parameter-min-set-comment":                         "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-moved":                                   "перемещён %s параметр запроса %s в %s параметр запроса %s",
+	"ru.messages.request-parameter-multiple-of-changed":                     "в %s параметре запроса %s, multipleOf изменён с %s на %s",
+	"ru.messages.request-parameter-multiple-of-set":                         "в %s параметре запроса %s, multipleOf установлен в %s",
+	"ru.messages.request-parameter-pattern-added":                           "добавлен pattern '%s' у %s параметра запроса %s",
+	"ru.messages.request-parameter-pattern-changed":                         "изменён pattern у %s параметра запроса %s со значения '%s' на значение '%s'",
+	"ru.messages.request-parameter-removed":                                 "удалён %s параметр запроса %s",
+	"ru.messages.request-parameter-renamed":                                 "переименован %s параметр запроса %s в %s",
+	"ru.messages.request-parameter-style-changed":                           "в %s параметре запроса %s, style изменился с %s на %s",
+	"ru.messages.request-parameter-type-changed":                            "в %s параметре запроса %s, type/format изменился с %s/%s на %s/%s",
+	"ru.messages.request-parameter-unique-items-set":                        "в %s параметре запроса %s, uniqueItems установлен",
+	"ru.messages.request-parameter-x-extensible-enum-value-removed":         "удалено из x-extensible-enum значение %s у %s параметра запроса %s",
+	"ru.messages.request-property-additional-properties-disallowed":         "свойство запроса %s больше не допускает дополнительные свойства",
+	"ru.messages.request-property-any-of-added":                             "добавлена схема %s в список 'anyOf' свойства запроса %s",
+	"ru.messages.request-property-any-of-removed":                           "удалена схема %s из списка 'anyOf' свойства запроса %s",
+	"ru.messages.request-property-became-enum":                              "свойство запроса %s было ограничено списком значений перечисления",
+	"ru.messages.request-property-became-not-nullable":                      "свойство запроса %s стало недействительным",
+	"ru.messages.request-property-became-not-read-only":                     "поле запроса %s перестало быть read-only",
+	"ru.messages.request-property-became-read-only":                         "поле запроса %s стало read-only",
+	"ru.messages.request-property-became-required":                          "поле запроса %s стало обязательным",
+	"ru.messages.request-property-default-value-added":                      "у поля запроса %s добавлено значение по умолчанию %s",
+	"ru.messages.request-property-default-value-changed":                    "у поля запроса %s значение по умолчанию изменено с %s на %s",
+	"ru.messages.request-property-default-value-removed":                    "у поля запроса %s удалено значение по умолчанию %s",
+	"ru.messages.request-property-discriminator-added":                      "добавлен discriminator свойства запроса %s",
+	"ru.messages.request-property-discriminator-mapping-added":              "добавлено значение %s в mapping discriminator свойства запроса %s",
+	"ru.messages.request-property-discriminator-mapping-changed":            "значение %s в mapping discriminator изменено с %s на %s для свойства запроса %s",
+	"ru.messages.request-property-discriminator-mapping-removed":            "удалено значение %s из mapping discriminator свойства запроса %s",
+	"ru.messages.request-property-discriminator-property-name-changed":      "имя свойства discriminator изменено с %s на %s для свойства запроса %s",
+	"ru.messages.request-property-discriminator-removed":                    "удалён discriminator свойства запроса %s",
+	"ru.messages.request-property-enum-value-removed":                       "удалено enum значение %s у поля запроса %s",
+	"ru.messages.request-property-max-became-exclusive":                     "максимум %s стал исключающим в поле запроса %s",
+	"ru.messages.request-property-max-decreased":                            "значение max у поля запроса %s уменьшено до %s",
+	"ru.messages.request-property-max-items-decreased":                      "maxItems уменьшен с %s до %s в поле запроса %s",
+	"ru.messages.request-property-max-items-set":                            "maxItems установлен в %s в поле запроса %s",
+	"ru.messages.request-property-max-items-set-comment":                    "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-max-length-decreased":                     "значение maxLength у поля запроса %s уменьшено до %s",
+	"ru.messages.request-property-max-length-set":                           "у поля запроса %s задано значение maxLength в %s",
+	"ru.messages.request-property-max-length-set-comment":                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-max-properties-decreased":                 "maxProperties уменьшен с %s до %s в поле запроса %s",
+	"ru.messages.request-property-max-properties-set":                       "maxProperties установлен в %s в поле запроса %s",
+	"ru.messages.request-property-max-properties-set-comment":               "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-max-set":                                  "у поля запроса %s задано значение max в %s",
+	"ru.messages.request-property-max-set-comment":                          "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-min-became-exclusive":                     "минимум %s стал исключающим в поле запроса %s",
+	"ru.messages.request-property-min-increased":                            "у поля запроса %s, увеличено значение min до %s",
+	"ru.messages.request-property-min-items-increased":                      "значение minItems у поля запроса %s увеличено до %s",
+	"ru.messages.request-property-min-items-set":                            "у поля запроса %s задано значение minItems в %s",
+	"ru.messages.request-property-min-items-set-comment":                    "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-min-properties-increased":                 "minProperties увеличен с %s до %s в поле запроса %s",
+	"ru.messages.request-property-min-set":                                  "у поля запроса %s задано значение min в %s",
+	"ru.messages.request-property-min-set-comment":                          "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-multiple-of-changed":                      "multipleOf изменён с %s на %s в поле запроса %s",
+	"ru.messages.request-property-multiple-of-set":                          "multipleOf установлен в %s в поле запроса %s",
+	"ru.messages.request-property-not-modified":                             "изменена схема not поля запроса %s",
+	"ru.messages.request-property-one-of-added":                             "добавлена схема %s в список 'oneOf' свойства запроса %s",
+	"ru.messages.request-property-one-of-removed":                           "удалена схема %s из списка 'oneOf' свойства запроса %s",
+	"ru.messages.request-property-pattern-added":                            "добавлен pattern '%s' у поля запроса %s",
+	"ru.messages.request-property-pattern-changed":                          "изменён pattern у поля запроса %s со значения '%s' на значение '%s'",
+	"ru.messages.request-property-removed":                                  "удалено поле запроса %s",
+	"ru.messages.request-property-type-changed":                             "у поля запроса %s изменился type/format с %s/%s на %s/%s",
+	"ru.messages.request-property-unique-items-set":                         "uniqueItems установлен в поле запроса %s",
+	"ru.messages.request-property-x-extensible-enum-value-removed":          "удалено значение x-extensible-enum '%s' в поле запроса %s",
+	"ru.messages.request-required-property-became-not-read-only":            "обязательное поле запроса %s перестало быть read-only",
+	"ru.messages.request-required-property-became-read-only":                "обязательное поле запроса %s стало read-only",
+	"ru.messages.required-response-header-removed":                          "удалён ранее обязательный заголовок ответа %s для ответа со статусом %s",
+	"ru.messages.response-body-any-of-added":                                "добавлена схема %s в список 'anyOf' тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-any-of-removed":                              "удалена схема %s из списка 'anyOf' тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-became-nullable":                             "у тела ответа стало обнуляемым",
+	"ru.messages.response-body-discriminator-added":                         "добавлен discriminator тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-discriminator-mapping-added":                 "добавлено значение %s в mapping discriminator тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-discriminator-mapping-changed":               "значение %s в mapping discriminator изменено с %s на %s для тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-discriminator-mapping-removed":               "удалено значение %s из mapping discriminator тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-discriminator-property-name-changed":         "имя свойства discriminator изменено с %s на %s для тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-discriminator-removed":                       "удалён discriminator тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-max-became-inclusive":                        "у тела ответа максимум %s стал включающим для ответа со статусом %s",
+	"ru.messages.response-body-max-increased":                               "у тела ответа max увеличен с %s до %s",
+	"ru.messages.response-body-max-items-increased":                         "у тела ответа maxItems увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-body-max-items-unset":                             "у тела ответа maxItems удалён, предыдущее значение - %s для ответа со статусом %s",
+	"ru.messages.response-body-max-length-increased":                        "у тела ответа maxLength увеличен с %s до %s",
+	"ru.messages.response-body-max-length-unset":                            "у тела ответа maxLength был удалён, предыдущее значение - %s",
+	"ru.messages.response-body-max-properties-increased":                    "у тела ответа maxProperties увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-body-max-properties-unset":                        "у тела ответа maxProperties удалён, предыдущее значение - %s для ответа со статусом %s",
+	"ru.messages.response-body-min-became-inclusive":                        "у тела ответа минимум %s стал включающим для ответа со статусом %s",
+	"ru.messages.response-body-min-decreased":                               "у тела ответа min уменьшено с %s до %s",
+	"ru.messages.response-body-min-items-decreased":                         "у тела ответа minItems уменьшено с %s до %s",
+	"ru.messages.response-body-min-items-unset":                             "удалено значение minItems для тела ответа, предыдущее значение - %s",
+	"ru.messages.response-body-min-length-decreased":                        "значение minLength для тела ответа уменьшено с %s до %s",
+	"ru.messages.response-body-min-properties-decreased":                    "у тела ответа minProperties уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-body-multiple-of-changed":                         "у тела ответа multipleOf изменён с %s на %s для ответа со статусом %s",
+	"ru.messages.response-body-multiple-of-unset":                           "у тела ответа multipleOf удалён, предыдущее значение - %s для ответа со статусом %s",
+	"ru.messages.response-body-not-modified":                                "изменена схема not тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-one-of-added":                                "добавлена схема %s в список 'oneOf' тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-one-of-removed":                              "удалена схема %s из списка 'oneOf' тела ответа для ответа со статусом %s",
+	"ru.messages.response-body-type-changed":                                "у тела ответа type/format изменился с %s/%s на %s/%s для ответа со статусом %s",
+	"ru.messages.response-body-unique-items-unset":                          "у тела ответа uniqueItems удалён для ответа со статусом %s",
+	"ru.messages.response-header-became-optional":                           "заголовок ответа %s стал необязательным для ответа со статусом %s",
+	"ru.messages.response-header-enum-value-added":                          "добавлено новое enum значение %s в заголовок ответа %s для ответа со статусом %s",
+	"ru.messages.response-header-enum-value-added-comment":                  "Добавление новых значений перечисления в заголовки ответа может быть неожиданным для клиентов, вместо этого используйте x-extensible-enum.",
+	"ru.messages.response-header-enum-value-removed":                        "удалено enum значение %s из заголовка ответа %s для ответа со статусом %s",
+	"ru.messages.response-header-max-became-inclusive":                      "у заголовка ответа %s максимум %s стал включающим для ответа со статусом %s",
+	"ru.messages.response-header-max-increased":                             "у заголовка ответа %s max увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-max-items-increased":                       "у заголовка ответа %s maxItems увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-max-items-unset":                           "у заголовка ответа %s maxItems удалён, предыдущее значение - %s для ответа со статусом %s",
+	"ru.messages.response-header-max-length-increased":                      "у заголовка ответа %s maxLength увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-max-length-unset":                          "у заголовка ответа %s maxLength был удалён, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-header-max-properties-increased":                  "у заголовка ответа %s maxProperties увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-max-properties-unset":                      "у заголовка ответа %s maxProperties удалён, предыдущее значение - %s для ответа со статусом %s",
+	"ru.messages.response-header-max-unset":                                 "у заголовка ответа %s max был удалён, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-header-min-became-inclusive":                      "у заголовка ответа %s минимум %s стал включающим для ответа со статусом %s",
+	"ru.messages.response-header-min-decreased":                             "у заголовка ответа %s min уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-min-length-decreased":                      "у заголовка ответа %s minLength уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-min-properties-decreased":                  "у заголовка ответа %s minProperties уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-header-min-unset":                                 "у заголовка ответа %s min был удалён, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-header-multiple-of-changed":                       "у заголовка ответа %s multipleOf изменён с %s на %s для ответа со статусом %s",
+	"ru.messages.response-header-multiple-of-unset":                         "у заголовка ответа %s multipleOf удалён, предыдущее значение - %s для ответа со статусом %s",
+	"ru.messages.response-header-pattern-added":                             "добавлен шаблон %s для заголовка ответа %s для ответа со статусом %s",
+	"ru.messages.response-header-pattern-changed":                           "изменён шаблон заголовка ответа %s с %s на %s для ответа со статусом %s",
+	"ru.messages.response-header-pattern-removed":                           "удалён шаблон %s заголовка ответа %s для ответа со статусом %s",
+	"ru.messages.response-header-type-changed":                              "у заголовка ответа %s изменился тип/формат с %s/%s на %s/%s для ответа со статусом %s",
+	"ru.messages.response-header-unique-items-unset":                        "у заголовка ответа %s uniqueItems удалён для ответа со статусом %s",
+	"ru.messages.response-media-type-added":                                 "добавлен media type %s для ответа со статусом %s",
+	"ru.messages.response-media-type-removed":                               "удалён media type %s для ответа со статусом %s",
+	"ru.messages.response-mediatype-enum-value-removed":                     "значение перечисления схемы ответа %s удалено %s",
+	"ru.messages.response-non-success-status-removed":                       "удален неуспешный (не 2xx) статус ответа %s",
+	"ru.messages.response-optional-property-removed":                        "удалено необязательное поле %s из ответа со статусом %s",
+	"ru.messages.response-property-any-of-added":                            "добавлена схема %s в список 'anyOf' свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-any-of-removed":                          "удалена схема %s из списка 'anyOf' свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-became-not-write-only":                   "поле ответа %s перестало быть write-only для ответа со статусом %s",
+	"ru.messages.response-property-became-nullable":                         "поле ответа %s стало обнуляемым для ответа со статусом %s",
+	"ru.messages.response-property-became-optional":                         "поле ответа %s стало необязательным для ответа со статусом %s",
+	"ru.messages.response-property-became-write-only":                       "поле ответа %s стало write-only для ответа со статусом %s",
+	"ru.messages.response-property-discriminator-added":                     "добавлен discriminator свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-discriminator-mapping-added":             "добавлено значение %s в mapping discriminator свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-discriminator-mapping-changed":           "значение %s в mapping discriminator изменено с %s на %s для свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-discriminator-mapping-removed":           "удалено значение %s из mapping discriminator свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-discriminator-property-name-changed":     "имя свойства discriminator изменено с %s на %s для свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-discriminator-removed":                   "удалён discriminator свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-enum-value-added":                        "добавлено новое enum значение %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-enum-value-added-comment":                "Добавление новых значений перечисления в ответ может быть неожиданным для клиентов, вместо этого используйте x-extensible-enum.",
+	"ru.messages.response-property-enum-value-removed":                      "удалено значение перечисления '%s' из свойства ответа %s для статуса ответа %s.",
+	"ru.messages.response-property-max-became-inclusive":                    "максимум %s стал включающим в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-max-increased":                           "у поля ответа %s max увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-max-items-increased":                     "maxItems увеличен с %s до %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-max-items-unset":                         "maxItems удалён, предыдущее значение - %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-max-length-increased":                    "у поля ответа %s maxLength увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-max-length-unset":                        "у поля ответа %s maxLength был удалён, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-property-max-properties-increased":                "maxProperties увеличен с %s до %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-max-properties-unset":                    "maxProperties удалён, предыдущее значение - %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-min-became-inclusive":                    "минимум %s стал включающим в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-min-decreased":                           "для поля ответа %s min уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-min-items-decreased":                     "у поля ответа %s уменьшено minItems с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-min-items-unset":                         "у поля ответа %s удалено значение minItems, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-property-min-length-decreased":                    "для поля ответа %s minLength уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-min-properties-decreased":                "minProperties уменьшен с %s до %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-multiple-of-changed":                     "multipleOf изменён с %s на %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-multiple-of-unset":                       "multipleOf удалён, предыдущее значение - %s в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-not-modified":                            "изменена схема not поля ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-one-of-added":                            "добавлена схема %s в список 'oneOf' свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-one-of-removed":                          "удалена схема %s из списка 'oneOf' свойства ответа %s для ответа со статусом %s",
+	"ru.messages.response-property-type-changed":                            "у поля type/format изменился с %s/%s на %s/%s для ответа со статусом %s",
+	"ru.messages.response-property-unique-items-unset":                      "uniqueItems удалён в поле ответа %s для ответа со статусом %s",
+	"ru.messages.response-required-property-became-not-write-only":          "обязательное поле ответа %s перестало быть write-only для ответа со статусом %s",
+	"ru.messages.response-required-property-became-not-write-only-comment":  "Изменение допустимо только в том случае, если свойство ВСЕГДА возвращалось ДО изменения спецификации.",
+	"ru.messages.response-required-property-became-write-only":              "обязательное поле ответа %s стало write-only для ответа со статусом %s",
+	"ru.messages.response-required-property-removed":                        "удалено обязательное поле ответа %s из ответа со статусом %s",
+	"ru.messages.response-schema-changed-partially":                         "схема ответа со статусом %s изменена в media types %s, но не в %s",
+	"ru.messages.response-success-status-removed":                           "удален успешный (2xx) статус ответа %s",
+	"ru.messages.sunset-deleted":                                            "удалена дата sunset date у API, но сохранён deprecated=true",
+	"ru.messages.total-errors":                                              "Ошибки обратной совместимости (всего: %d):\n",
+	"ru.messages.webhook-added":                                             "добавлен вебхук %s",
+	"ru.messages.webhook-operation-added":                                   "добавлена операция %s в вебхук %s",
+	"ru.messages.webhook-operation-removed":                                 "удалена операция %s из вебхука %s",
+	"ru.messages.webhook-removed":                                           "удалён вебхук %s, подписчики больше не будут его получать",
+	"ru.messages.webhook-request-changed":                                   "в запросе вебхука %s, который отправляется подписчикам: %s",
+	"ru.messages.webhook-request-status":                                    "запрос вебхука",
+	"ru.messages.webhook-response-changed":                                  "в ответе со статусом %s вебхука %s, который отправляется подписчиками и проверяется как запрос: %s",
 }
 
 type Replacements map[string]interface{}
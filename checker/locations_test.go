@@ -0,0 +1,213 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+)
+
+func getLocatedChanges(t *testing.T, diffConfig *diff.Config, base, revision string, modify func(s1, s2 *load.SpecInfo)) checker.BackwardCompatibilityErrors {
+	t.Helper()
+
+	s1, err := open(base)
+	require.NoError(t, err)
+	s2, err := open(revision)
+	require.NoError(t, err)
+	if modify != nil {
+		modify(s1, s2)
+	}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diffConfig, s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(getLocatedConfig(s1, s2), d, osm, checker.INFO)
+	require.NotEmpty(t, errs)
+	return errs
+}
+
+// getLocatedConfig returns the default checks with the locations of the elements of the specs
+func getLocatedConfig(s1, s2 *load.SpecInfo) checker.BackwardCompatibilityCheckConfig {
+	config := checker.GetDefaultChecks()
+	config.Locations = diff.GetLocationsMap([]load.SpecInfo{*s1}, []load.SpecInfo{*s2})
+	return config
+}
+
+func getLocatedChange(t *testing.T, errs checker.BackwardCompatibilityErrors, id, text string) checker.BackwardCompatibilityError {
+	t.Helper()
+
+	for _, err := range errs {
+		if err.Id == id && err.UncolorizedText() == text {
+			return err
+		}
+	}
+	require.Failf(t, "change not found", "%s: %s", id, text)
+	return checker.BackwardCompatibilityError{}
+}
+
+func location(file string, line, column int) *load.Location {
+	return &load.Location{File: file, Line: line, Column: column}
+}
+
+func TestLocations(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 3)
+
+	var err error
+	s1.Locations, err = load.GetFileLocations(s1.Url)
+	require.NoError(t, err)
+	s2.Locations, err = load.GetFileLocations(s2.Url)
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(getLocatedConfig(&s1, &s2), d, osm)
+	require.NotEmpty(t, errs)
+
+	for _, bcErr := range errs {
+		require.NotNil(t, bcErr.BaseSource)
+	}
+
+	// the removed parameter is located in the base, and the operation in the revision
+	bcErr := getLocatedChange(t, errs, "request-parameter-removed", "deleted the 'query' request parameter 'filter'")
+	require.Equal(t, location("../data/openapi-test1.yaml", 40, 9), bcErr.BaseSource)
+	require.Equal(t, location("../data/openapi-test3.yaml", 18, 5), bcErr.RevisionSource)
+	require.Equal(t, "../data/openapi-test3.yaml:18:5", bcErr.SourceLocation())
+
+	// the removed response is located in the base
+	bcErr = getLocatedChange(t, errs, "response-success-status-removed", "removed the success response with the status '200'")
+	require.Equal(t, location("../data/openapi-test1.yaml", 116, 9), bcErr.BaseSource)
+	require.Equal(t, location("../data/openapi-test3.yaml", 18, 5), bcErr.RevisionSource)
+
+	// changes in the security schemes are located at the scheme
+	bcErr = getLocatedChange(t, errs, "api-security-component-removed", "removed the security scheme 'bearerAuth' from openapi components")
+	require.Equal(t, location("../data/openapi-test1.yaml", 293, 5), bcErr.BaseSource)
+	require.Nil(t, bcErr.RevisionSource)
+	require.Equal(t, "../data/openapi-test1.yaml:293:5", bcErr.SourceLocation())
+
+	bcErr = getLocatedChange(t, errs, "api-security-component-oauth-url-changed", "changed the tokenUrl of the oauth flow 'authorizationCode' of the security scheme 'OAuth' from 'https://tufin.io/token' to 'https://example.org/token'")
+	require.Equal(t, location("../data/openapi-test1.yaml", 300, 5), bcErr.BaseSource)
+	require.Equal(t, location("../data/openapi-test3.yaml", 252, 5), bcErr.RevisionSource)
+}
+
+func TestLocations_DeletedPath(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 701)
+
+	var err error
+	s1.Locations, err = load.GetFileLocations(s1.Url)
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(getLocatedConfig(&s1, &s2), d, osm)
+	require.NotEmpty(t, errs)
+
+	for _, bcErr := range errs {
+		require.NotNil(t, bcErr.BaseSource)
+		require.Nil(t, bcErr.RevisionSource)
+	}
+}
+
+func TestLocations_Property(t *testing.T) {
+	errs := getLocatedChanges(t, getConfig(), getReqPropFile("request-property-items.yaml"), getReqPropFile("request-property-items-2.yaml"), nil)
+
+	// the property is located at its definition in the components, and in the revision, where it was removed, at the schema which contained it
+	bcErr := getLocatedChange(t, errs, "request-property-removed", "removed the request property 'roleAssignments/items/role'")
+	require.Equal(t, location("../data/required-properties/request-property-items.yaml", 28, 9), bcErr.BaseSource)
+	require.Equal(t, location("../data/required-properties/request-property-items-2.yaml", 25, 5), bcErr.RevisionSource)
+}
+
+func TestLocations_Webhook(t *testing.T) {
+	errs := getLocatedChanges(t, getConfig(), webhooksSpec, webhooksSpec, func(s1, s2 *load.SpecInfo) {
+		delete(s2.Webhooks, "deletedPet")
+		delete(petSchema(s2).Properties, "name")
+		petSchema(s2).Required = []string{"id"}
+	})
+
+	bcErr := getLocatedChange(t, errs, "webhook-removed", "removed the webhook 'deletedPet', subscribers will no longer receive it")
+	require.Equal(t, location(webhooksSpec, 24, 3), bcErr.BaseSource)
+	require.Nil(t, bcErr.RevisionSource)
+
+	bcErr = getLocatedChange(t, errs, "webhook-response-required-property-removed", "in the request of the webhook 'POST newPet', which is sent to subscribers: removed the required property 'name' from the response with the 'webhook request' status")
+	require.Equal(t, location(webhooksSpec, 49, 9), bcErr.BaseSource)
+	require.Equal(t, location(webhooksSpec, 41, 5), bcErr.RevisionSource)
+}
+
+func TestLocations_RenamedOperation(t *testing.T) {
+	errs := getLocatedChanges(t, getMatchOperationIdsConfig(), "../data/operation-ids/base.yaml", "../data/operation-ids/revision.yaml", nil)
+
+	bcErr := getLocatedChange(t, errs, "new-required-request-parameter", "added the new required 'query' request parameter 'fields'")
+	require.Equal(t, location("../data/operation-ids/base.yaml", 7, 5), bcErr.BaseSource)
+	require.Equal(t, location("../data/operation-ids/revision.yaml", 15, 11), bcErr.RevisionSource)
+
+	bcErr = getLocatedChange(t, errs, "api-operation-endpoint-changed", "the endpoint of the operation 'deleteUser' was changed from 'DELETE /v1/users/{id}' to 'POST /users/{userId}/remove'")
+	require.Equal(t, location("../data/operation-ids/base.yaml", 18, 5), bcErr.BaseSource)
+	require.Equal(t, location("../data/operation-ids/revision.yaml", 24, 5), bcErr.RevisionSource)
+}
+
+func TestLocations_ExternalRefs(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	s1, err := load.LoadSpecInfoFromFile(loader, "../data/locations/external/spec1.yaml")
+	require.NoError(t, err)
+	s2, err := load.LoadSpecInfoFromFile(loader, "../data/locations/external/spec2.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(getLocatedConfig(s1, s2), d, osm, checker.INFO)
+
+	// the elements are located in the files which define them
+	bcErr := getLocatedChange(t, errs, "request-parameter-max-decreased", "for the 'query' request parameter 'limit', the max was decreased from '100.00' to '10.00'")
+	require.Equal(t, location("../data/locations/external/sub/common1.yaml", 3, 5), bcErr.BaseSource)
+	require.Equal(t, location("../data/locations/external/sub/common2.yaml", 3, 5), bcErr.RevisionSource)
+
+	bcErr = getLocatedChange(t, errs, "response-required-property-removed", "removed the required property 'name' from the response with the '200' status")
+	require.Equal(t, location("../data/locations/external/sub/common1.yaml", 15, 9), bcErr.BaseSource)
+	require.Equal(t, location("../data/locations/external/sub/common2.yaml", 10, 5), bcErr.RevisionSource)
+
+	// references within the external files are resolved relative to them
+	for _, bcErr := range errs {
+		if bcErr.Id == "response-property-type-changed" {
+			require.Equal(t, location("../data/locations/external/sub/common1.yaml", 22, 9), bcErr.BaseSource)
+			require.Equal(t, location("../data/locations/external/sub/common2.yaml", 18, 9), bcErr.RevisionSource)
+			return
+		}
+	}
+	require.Fail(t, "response-property-type-changed not found")
+}
+
+func TestLocations_NoLocations(t *testing.T) {
+	errs := d(t, getConfig(), 1, 3)
+	require.NotEmpty(t, errs)
+	for _, bcErr := range errs {
+		require.Nil(t, bcErr.BaseSource)
+		require.Nil(t, bcErr.RevisionSource)
+		require.Equal(t, bcErr.Source, bcErr.SourceLocation())
+	}
+}
+
+func TestLocations_AllErrorsHaveLocations(t *testing.T) {
+	for _, specs := range getFixtures(t) {
+		for _, s1 := range specs {
+			for _, s2 := range specs {
+				d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+				if err != nil {
+					continue
+				}
+				config := checker.GetAllChecks(utils.StringList{})
+				config.Locations = diff.GetLocationsMap([]load.SpecInfo{*s1}, []load.SpecInfo{*s2})
+				for _, bcErr := range checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO) {
+					if bcErr.Id == "parsing-error" {
+						continue
+					}
+					require.True(t, bcErr.BaseSource != nil || bcErr.RevisionSource != nil, "%s: %s -> %s", bcErr.Id, s1.Url, s2.Url)
+				}
+			}
+		}
+	}
+}
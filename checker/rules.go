@@ -0,0 +1,405 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tufin/oasdiff/checker/localizations"
+)
+
+// BackwardCompatibilityRule describes a kind of change that can be reported by the checks
+type BackwardCompatibilityRule struct {
+	Id          string `json:"id" yaml:"id"`
+	Level       Level  `json:"level" yaml:"level"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// rulesLocalizer provides the descriptions of the rules, see localizations_src/en/descriptions.yaml
+var rulesLocalizer = localizations.New("en", "en")
+
+// allRules and rulesById are built once, see GetAllRules and GetRule
+var (
+	allRules  = getAllRules()
+	rulesById = getRulesById(allRules)
+)
+
+func newBackwardCompatibilityRule(id string, level Level) BackwardCompatibilityRule {
+	return BackwardCompatibilityRule{
+		Id:          id,
+		Level:       level,
+		Description: rulesLocalizer.Get("descriptions." + id),
+	}
+}
+
+// GetAllRules returns the rules of all the checks, including the optional ones, with their default levels
+func GetAllRules() []BackwardCompatibilityRule {
+	return append([]BackwardCompatibilityRule{}, allRules...)
+}
+
+func getAllRules() []BackwardCompatibilityRule {
+	rules := []BackwardCompatibilityRule{
+		// general
+		newBackwardCompatibilityRule("parsing-error", ERR),
+		newBackwardCompatibilityRule("api-stability-decreased", ERR),
+		// endpoints
+		newBackwardCompatibilityRule("endpoint-added", INFO),
+		newBackwardCompatibilityRule("endpoint-deprecated", INFO),
+		newBackwardCompatibilityRule("endpoint-reactivated", INFO),
+		newBackwardCompatibilityRule("api-path-removed-without-deprecation", ERR),
+		newBackwardCompatibilityRule("api-path-removed-before-sunset", ERR),
+		newBackwardCompatibilityRule("api-path-sunset-parse", ERR),
+		newBackwardCompatibilityRule("api-removed-without-deprecation", ERR),
+		newBackwardCompatibilityRule("api-removed-before-sunset", ERR),
+		newBackwardCompatibilityRule("api-deprecated-sunset-parse", ERR),
+		newBackwardCompatibilityRule("api-sunset-date-too-small", ERR),
+		newBackwardCompatibilityRule("api-sunset-date-changed-too-small", ERR),
+		newBackwardCompatibilityRule("api-operation-endpoint-changed", ERR),
+		newBackwardCompatibilityRule("sunset-deleted", ERR),
+		newBackwardCompatibilityRule("api-operation-id-removed", INFO),
+		newBackwardCompatibilityRule("api-tag-removed", INFO),
+		newBackwardCompatibilityRule("api-schema-removed", INFO),
+		// security
+		newBackwardCompatibilityRule("api-security-added", INFO),
+		newBackwardCompatibilityRule("api-security-became-required", ERR),
+		newBackwardCompatibilityRule("api-security-became-optional", INFO),
+		newBackwardCompatibilityRule("api-security-removed", ERR),
+		newBackwardCompatibilityRule("api-security-scope-added", ERR),
+		newBackwardCompatibilityRule("api-security-scope-removed", INFO),
+		newBackwardCompatibilityRule("api-global-security-added", INFO),
+		newBackwardCompatibilityRule("api-global-security-became-required", ERR),
+		newBackwardCompatibilityRule("api-global-security-became-optional", INFO),
+		newBackwardCompatibilityRule("api-global-security-removed", ERR),
+		newBackwardCompatibilityRule("api-global-security-scope-added", ERR),
+		newBackwardCompatibilityRule("api-global-security-scope-removed", INFO),
+		newBackwardCompatibilityRule("api-security-component-added", INFO),
+		newBackwardCompatibilityRule("api-security-component-removed", WARN),
+		newBackwardCompatibilityRule("api-security-component-type-changed", ERR),
+		newBackwardCompatibilityRule("api-security-component-name-changed", ERR),
+		newBackwardCompatibilityRule("api-security-component-in-changed", ERR),
+		newBackwardCompatibilityRule("api-security-component-scheme-changed", ERR),
+		newBackwardCompatibilityRule("api-security-component-bearer-format-changed", WARN),
+		newBackwardCompatibilityRule("api-security-component-openid-url-changed", WARN),
+		newBackwardCompatibilityRule("api-security-component-oauth-flow-added", INFO),
+		newBackwardCompatibilityRule("api-security-component-oauth-flow-removed", ERR),
+		newBackwardCompatibilityRule("api-security-component-oauth-url-changed", WARN),
+		newBackwardCompatibilityRule("api-security-component-oauth-scope-added", INFO),
+		newBackwardCompatibilityRule("api-security-component-oauth-scope-removed", WARN),
+		// request body
+		newBackwardCompatibilityRule("added-required-request-body", ERR),
+		newBackwardCompatibilityRule("request-body-became-required", ERR),
+		newBackwardCompatibilityRule("request-body-became-enum", ERR),
+		newBackwardCompatibilityRule("request-body-enum-value-removed", INFO),
+		newBackwardCompatibilityRule("request-body-became-not-nullable", ERR),
+		newBackwardCompatibilityRule("request-body-type-changed", ERR),
+		newBackwardCompatibilityRule("request-body-max-decreased", ERR),
+		newBackwardCompatibilityRule("request-body-max-set", WARN),
+		newBackwardCompatibilityRule("request-body-min-increased", ERR),
+		newBackwardCompatibilityRule("request-body-min-set", WARN),
+		newBackwardCompatibilityRule("request-body-max-length-decreased", ERR),
+		newBackwardCompatibilityRule("request-body-max-length-set", WARN),
+		newBackwardCompatibilityRule("request-body-min-items-increased", ERR),
+		newBackwardCompatibilityRule("request-body-min-items-set", WARN),
+		newBackwardCompatibilityRule("request-body-media-type-removed", ERR),
+		newBackwardCompatibilityRule("request-body-media-type-added", INFO),
+		newBackwardCompatibilityRule("request-body-schema-changed-partially", WARN),
+		newBackwardCompatibilityRule("request-body-additional-properties-disallowed", ERR),
+		// request parameters
+		newBackwardCompatibilityRule("new-required-request-parameter", ERR),
+		newBackwardCompatibilityRule("new-optional-request-parameter", INFO),
+		newBackwardCompatibilityRule("new-request-path-parameter", ERR),
+		newBackwardCompatibilityRule("request-parameter-removed", WARN),
+		newBackwardCompatibilityRule("request-parameter-renamed", ERR),
+		newBackwardCompatibilityRule("request-parameter-moved", ERR),
+		newBackwardCompatibilityRule("request-parameter-became-required", ERR),
+		newBackwardCompatibilityRule("request-parameter-became-optional", INFO),
+		newBackwardCompatibilityRule("request-parameter-became-enum", ERR),
+		newBackwardCompatibilityRule("request-parameter-enum-value-removed", ERR),
+		newBackwardCompatibilityRule("request-parameter-x-extensible-enum-value-removed", ERR),
+		newBackwardCompatibilityRule("unparseable-parameter-from-x-extensible-enum", ERR),
+		newBackwardCompatibilityRule("unparseable-paramater-to-x-extensible-enum", ERR),
+		newBackwardCompatibilityRule("request-parameter-pattern-added", WARN),
+		newBackwardCompatibilityRule("request-parameter-pattern-changed", WARN),
+		newBackwardCompatibilityRule("request-parameter-default-value-changed", ERR),
+		newBackwardCompatibilityRule("request-body-default-value-changed", ERR),
+		newBackwardCompatibilityRule("request-body-default-value-added", WARN),
+		newBackwardCompatibilityRule("request-body-default-value-removed", WARN),
+		newBackwardCompatibilityRule("request-property-default-value-changed", ERR),
+		newBackwardCompatibilityRule("request-property-default-value-added", WARN),
+		newBackwardCompatibilityRule("request-property-default-value-removed", WARN),
+		newBackwardCompatibilityRule("request-header-property-default-value-changed", ERR),
+		newBackwardCompatibilityRule("request-header-property-default-value-added", WARN),
+		newBackwardCompatibilityRule("request-header-property-default-value-removed", WARN),
+		newBackwardCompatibilityRule("request-parameter-type-changed", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-decreased", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-set", WARN),
+		newBackwardCompatibilityRule("request-parameter-min-increased", ERR),
+		newBackwardCompatibilityRule("request-parameter-min-set", WARN),
+		newBackwardCompatibilityRule("request-parameter-max-length-decreased", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-length-set", WARN),
+		newBackwardCompatibilityRule("request-parameter-min-items-increased", ERR),
+		newBackwardCompatibilityRule("request-parameter-min-items-set", WARN),
+		newBackwardCompatibilityRule("request-parameter-style-changed", ERR),
+		newBackwardCompatibilityRule("request-parameter-explode-changed", ERR),
+		newBackwardCompatibilityRule("request-parameter-allow-reserved-disabled", ERR),
+		// request headers
+		newBackwardCompatibilityRule("new-required-request-header-property", ERR),
+		newBackwardCompatibilityRule("request-header-property-became-required", ERR),
+		newBackwardCompatibilityRule("request-header-property-became-enum", ERR),
+		// request properties
+		newBackwardCompatibilityRule("new-required-request-property", ERR),
+		newBackwardCompatibilityRule("request-property-removed", WARN),
+		newBackwardCompatibilityRule("request-property-became-required", ERR),
+		newBackwardCompatibilityRule("request-property-became-enum", ERR),
+		newBackwardCompatibilityRule("request-property-became-not-nullable", ERR),
+		newBackwardCompatibilityRule("request-property-became-read-only", WARN),
+		newBackwardCompatibilityRule("request-required-property-became-read-only", ERR),
+		newBackwardCompatibilityRule("request-property-became-not-read-only", INFO),
+		newBackwardCompatibilityRule("request-required-property-became-not-read-only", ERR),
+		newBackwardCompatibilityRule("request-property-enum-value-removed", ERR),
+		newBackwardCompatibilityRule("request-property-x-extensible-enum-value-removed", ERR),
+		newBackwardCompatibilityRule("unparseable-property-from-x-extensible-enum", ERR),
+		newBackwardCompatibilityRule("unparseable-property-to-x-extensible-enum", ERR),
+		newBackwardCompatibilityRule("request-property-pattern-added", WARN),
+		newBackwardCompatibilityRule("request-property-pattern-changed", WARN),
+		newBackwardCompatibilityRule("request-property-type-changed", ERR),
+		newBackwardCompatibilityRule("request-property-max-decreased", ERR),
+		newBackwardCompatibilityRule("request-property-max-set", WARN),
+		newBackwardCompatibilityRule("request-property-min-increased", ERR),
+		newBackwardCompatibilityRule("request-property-min-set", WARN),
+		newBackwardCompatibilityRule("request-property-max-length-decreased", ERR),
+		newBackwardCompatibilityRule("request-property-max-length-set", WARN),
+		newBackwardCompatibilityRule("request-property-min-items-increased", ERR),
+		newBackwardCompatibilityRule("request-property-min-items-set", WARN),
+		newBackwardCompatibilityRule("request-allOf-modified", WARN),
+		newBackwardCompatibilityRule("request-body-not-modified", WARN),
+		newBackwardCompatibilityRule("request-property-not-modified", WARN),
+		newBackwardCompatibilityRule("request-property-additional-properties-disallowed", ERR),
+		// responses
+		newBackwardCompatibilityRule("response-success-status-removed", ERR),
+		newBackwardCompatibilityRule("response-non-success-status-removed", INFO),
+		newBackwardCompatibilityRule("response-media-type-removed", ERR),
+		newBackwardCompatibilityRule("response-media-type-added", INFO),
+		newBackwardCompatibilityRule("response-schema-changed-partially", WARN),
+		newBackwardCompatibilityRule("response-mediatype-enum-value-removed", ERR),
+		newBackwardCompatibilityRule("required-response-header-removed", ERR),
+		newBackwardCompatibilityRule("optional-response-header-removed", WARN),
+		newBackwardCompatibilityRule("response-header-became-optional", ERR),
+		newBackwardCompatibilityRule("response-header-type-changed", ERR),
+		newBackwardCompatibilityRule("response-header-enum-value-added", WARN),
+		newBackwardCompatibilityRule("response-header-enum-value-removed", INFO),
+		newBackwardCompatibilityRule("response-header-max-increased", ERR),
+		newBackwardCompatibilityRule("response-header-max-unset", ERR),
+		newBackwardCompatibilityRule("response-header-min-decreased", ERR),
+		newBackwardCompatibilityRule("response-header-min-unset", ERR),
+		newBackwardCompatibilityRule("response-header-max-length-increased", ERR),
+		newBackwardCompatibilityRule("response-header-max-length-unset", ERR),
+		newBackwardCompatibilityRule("response-header-min-length-decreased", ERR),
+		newBackwardCompatibilityRule("response-header-pattern-added", INFO),
+		newBackwardCompatibilityRule("response-header-pattern-changed", WARN),
+		newBackwardCompatibilityRule("response-header-pattern-removed", WARN),
+		newBackwardCompatibilityRule("webhook-added", INFO),
+		newBackwardCompatibilityRule("webhook-removed", ERR),
+		newBackwardCompatibilityRule("webhook-operation-added", INFO),
+		newBackwardCompatibilityRule("webhook-operation-removed", ERR),
+		newBackwardCompatibilityRule("callback-added", INFO),
+		newBackwardCompatibilityRule("callback-removed", ERR),
+		newBackwardCompatibilityRule("callback-path-added", INFO),
+		newBackwardCompatibilityRule("callback-path-removed", ERR),
+		newBackwardCompatibilityRule("callback-operation-added", INFO),
+		newBackwardCompatibilityRule("callback-operation-removed", ERR),
+		newBackwardCompatibilityRule("callback-request-required-property-added", ERR),
+		newBackwardCompatibilityRule("webhook-request-required-property-added", ERR),
+		newBackwardCompatibilityRule("response-body-became-nullable", ERR),
+		newBackwardCompatibilityRule("response-body-type-changed", ERR),
+		newBackwardCompatibilityRule("response-body-max-increased", ERR),
+		newBackwardCompatibilityRule("response-body-min-decreased", ERR),
+		newBackwardCompatibilityRule("response-body-max-length-increased", ERR),
+		newBackwardCompatibilityRule("response-body-max-length-unset", ERR),
+		newBackwardCompatibilityRule("response-body-min-length-decreased", ERR),
+		newBackwardCompatibilityRule("response-body-min-items-decreased", ERR),
+		newBackwardCompatibilityRule("response-body-min-items-unset", ERR),
+		// response properties
+		newBackwardCompatibilityRule("response-required-property-removed", ERR),
+		newBackwardCompatibilityRule("response-optional-property-removed", WARN),
+		newBackwardCompatibilityRule("response-property-became-optional", ERR),
+		newBackwardCompatibilityRule("response-property-became-nullable", ERR),
+		newBackwardCompatibilityRule("response-required-property-became-not-write-only", WARN),
+		newBackwardCompatibilityRule("response-property-became-write-only", WARN),
+		newBackwardCompatibilityRule("response-required-property-became-write-only", ERR),
+		newBackwardCompatibilityRule("response-property-became-not-write-only", INFO),
+		newBackwardCompatibilityRule("response-property-enum-value-added", WARN),
+		newBackwardCompatibilityRule("response-property-enum-value-removed", INFO),
+		newBackwardCompatibilityRule("response-property-type-changed", ERR),
+		newBackwardCompatibilityRule("response-property-max-increased", ERR),
+		newBackwardCompatibilityRule("response-property-min-decreased", ERR),
+		newBackwardCompatibilityRule("response-property-max-length-increased", ERR),
+		newBackwardCompatibilityRule("response-property-max-length-unset", ERR),
+		newBackwardCompatibilityRule("response-property-min-length-decreased", ERR),
+		newBackwardCompatibilityRule("response-property-min-items-decreased", ERR),
+		newBackwardCompatibilityRule("response-property-min-items-unset", ERR),
+		newBackwardCompatibilityRule("response-allOf-modified", WARN),
+		newBackwardCompatibilityRule("response-body-not-modified", WARN),
+		newBackwardCompatibilityRule("response-property-not-modified", WARN),
+		// polymorphism
+		newBackwardCompatibilityRule("request-body-one-of-added", ERR),
+		newBackwardCompatibilityRule("request-body-one-of-removed", ERR),
+		newBackwardCompatibilityRule("request-body-any-of-added", ERR),
+		newBackwardCompatibilityRule("request-body-any-of-removed", ERR),
+		newBackwardCompatibilityRule("request-body-discriminator-added", WARN),
+		newBackwardCompatibilityRule("request-body-discriminator-removed", INFO),
+		newBackwardCompatibilityRule("request-body-discriminator-property-name-changed", ERR),
+		newBackwardCompatibilityRule("request-body-discriminator-mapping-added", INFO),
+		newBackwardCompatibilityRule("request-body-discriminator-mapping-removed", ERR),
+		newBackwardCompatibilityRule("request-body-discriminator-mapping-changed", ERR),
+		newBackwardCompatibilityRule("request-property-one-of-added", ERR),
+		newBackwardCompatibilityRule("request-property-one-of-removed", ERR),
+		newBackwardCompatibilityRule("request-property-any-of-added", ERR),
+		newBackwardCompatibilityRule("request-property-any-of-removed", ERR),
+		newBackwardCompatibilityRule("request-property-discriminator-added", WARN),
+		newBackwardCompatibilityRule("request-property-discriminator-removed", INFO),
+		newBackwardCompatibilityRule("request-property-discriminator-property-name-changed", ERR),
+		newBackwardCompatibilityRule("request-property-discriminator-mapping-added", INFO),
+		newBackwardCompatibilityRule("request-property-discriminator-mapping-removed", ERR),
+		newBackwardCompatibilityRule("request-property-discriminator-mapping-changed", ERR),
+		newBackwardCompatibilityRule("response-body-one-of-added", WARN),
+		newBackwardCompatibilityRule("response-body-one-of-removed", ERR),
+		newBackwardCompatibilityRule("response-body-any-of-added", WARN),
+		newBackwardCompatibilityRule("response-body-any-of-removed", ERR),
+		newBackwardCompatibilityRule("response-body-discriminator-added", INFO),
+		newBackwardCompatibilityRule("response-body-discriminator-removed", WARN),
+		newBackwardCompatibilityRule("response-body-discriminator-property-name-changed", ERR),
+		newBackwardCompatibilityRule("response-body-discriminator-mapping-added", WARN),
+		newBackwardCompatibilityRule("response-body-discriminator-mapping-removed", ERR),
+		newBackwardCompatibilityRule("response-body-discriminator-mapping-changed", ERR),
+		newBackwardCompatibilityRule("response-property-one-of-added", WARN),
+		newBackwardCompatibilityRule("response-property-one-of-removed", ERR),
+		newBackwardCompatibilityRule("response-property-any-of-added", WARN),
+		newBackwardCompatibilityRule("response-property-any-of-removed", ERR),
+		newBackwardCompatibilityRule("response-property-discriminator-added", INFO),
+		newBackwardCompatibilityRule("response-property-discriminator-removed", WARN),
+		newBackwardCompatibilityRule("response-property-discriminator-property-name-changed", ERR),
+		newBackwardCompatibilityRule("response-property-discriminator-mapping-added", WARN),
+		newBackwardCompatibilityRule("response-property-discriminator-mapping-removed", ERR),
+		newBackwardCompatibilityRule("response-property-discriminator-mapping-changed", ERR),
+		// schema constraints
+		newBackwardCompatibilityRule("request-parameter-max-items-decreased", ERR),
+		newBackwardCompatibilityRule("request-body-max-items-decreased", ERR),
+		newBackwardCompatibilityRule("request-property-max-items-decreased", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-items-set", WARN),
+		newBackwardCompatibilityRule("request-body-max-items-set", WARN),
+		newBackwardCompatibilityRule("request-property-max-items-set", WARN),
+		newBackwardCompatibilityRule("response-body-max-items-increased", ERR),
+		newBackwardCompatibilityRule("response-property-max-items-increased", ERR),
+		newBackwardCompatibilityRule("response-header-max-items-increased", ERR),
+		newBackwardCompatibilityRule("response-body-max-items-unset", ERR),
+		newBackwardCompatibilityRule("response-property-max-items-unset", ERR),
+		newBackwardCompatibilityRule("response-header-max-items-unset", ERR),
+		newBackwardCompatibilityRule("request-parameter-min-properties-increased", ERR),
+		newBackwardCompatibilityRule("request-body-min-properties-increased", ERR),
+		newBackwardCompatibilityRule("request-property-min-properties-increased", ERR),
+		newBackwardCompatibilityRule("response-body-min-properties-decreased", ERR),
+		newBackwardCompatibilityRule("response-property-min-properties-decreased", ERR),
+		newBackwardCompatibilityRule("response-header-min-properties-decreased", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-properties-decreased", ERR),
+		newBackwardCompatibilityRule("request-body-max-properties-decreased", ERR),
+		newBackwardCompatibilityRule("request-property-max-properties-decreased", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-properties-set", WARN),
+		newBackwardCompatibilityRule("request-body-max-properties-set", WARN),
+		newBackwardCompatibilityRule("request-property-max-properties-set", WARN),
+		newBackwardCompatibilityRule("response-body-max-properties-increased", ERR),
+		newBackwardCompatibilityRule("response-property-max-properties-increased", ERR),
+		newBackwardCompatibilityRule("response-header-max-properties-increased", ERR),
+		newBackwardCompatibilityRule("response-body-max-properties-unset", ERR),
+		newBackwardCompatibilityRule("response-property-max-properties-unset", ERR),
+		newBackwardCompatibilityRule("response-header-max-properties-unset", ERR),
+		newBackwardCompatibilityRule("request-parameter-multiple-of-set", ERR),
+		newBackwardCompatibilityRule("request-body-multiple-of-set", ERR),
+		newBackwardCompatibilityRule("request-property-multiple-of-set", ERR),
+		newBackwardCompatibilityRule("request-parameter-multiple-of-changed", ERR),
+		newBackwardCompatibilityRule("request-body-multiple-of-changed", ERR),
+		newBackwardCompatibilityRule("request-property-multiple-of-changed", ERR),
+		newBackwardCompatibilityRule("response-body-multiple-of-unset", ERR),
+		newBackwardCompatibilityRule("response-property-multiple-of-unset", ERR),
+		newBackwardCompatibilityRule("response-header-multiple-of-unset", ERR),
+		newBackwardCompatibilityRule("response-body-multiple-of-changed", ERR),
+		newBackwardCompatibilityRule("response-property-multiple-of-changed", ERR),
+		newBackwardCompatibilityRule("response-header-multiple-of-changed", ERR),
+		newBackwardCompatibilityRule("request-parameter-unique-items-set", ERR),
+		newBackwardCompatibilityRule("request-body-unique-items-set", ERR),
+		newBackwardCompatibilityRule("request-property-unique-items-set", ERR),
+		newBackwardCompatibilityRule("response-body-unique-items-unset", ERR),
+		newBackwardCompatibilityRule("response-property-unique-items-unset", ERR),
+		newBackwardCompatibilityRule("response-header-unique-items-unset", ERR),
+		newBackwardCompatibilityRule("request-parameter-min-became-exclusive", ERR),
+		newBackwardCompatibilityRule("request-body-min-became-exclusive", ERR),
+		newBackwardCompatibilityRule("request-property-min-became-exclusive", ERR),
+		newBackwardCompatibilityRule("request-parameter-max-became-exclusive", ERR),
+		newBackwardCompatibilityRule("request-body-max-became-exclusive", ERR),
+		newBackwardCompatibilityRule("request-property-max-became-exclusive", ERR),
+		newBackwardCompatibilityRule("response-body-min-became-inclusive", ERR),
+		newBackwardCompatibilityRule("response-property-min-became-inclusive", ERR),
+		newBackwardCompatibilityRule("response-header-min-became-inclusive", ERR),
+		newBackwardCompatibilityRule("response-body-max-became-inclusive", ERR),
+		newBackwardCompatibilityRule("response-property-max-became-inclusive", ERR),
+		newBackwardCompatibilityRule("response-header-max-became-inclusive", ERR),
+	}
+
+	return append(rules, getInvertedOperationRules(rules)...)
+}
+
+// invertedRequestRulePrefixes and invertedResponseRulePrefixes are the prefixes of the ids of the request body and response checks which run on the operations of callbacks and webhooks
+var (
+	invertedRequestRulePrefixes  = []string{"request-body-", "request-property-", "request-required-property-", "new-required-request-property", "new-optional-request-property"}
+	invertedResponseRulePrefixes = []string{"response-body-", "response-property-", "response-required-property-", "response-optional-property-", "response-media-type-", "response-mediatype-", "response-schema-"}
+)
+
+/*
+getInvertedOperationRules returns the rules of the errors found in the operations of callbacks and webhooks, which are prefixed by callback- or webhook-, see checkInvertedOperation
+the request bodies of these operations are checked like responses, and their responses are checked like request bodies
+*/
+func getInvertedOperationRules(rules []BackwardCompatibilityRule) []BackwardCompatibilityRule {
+	result := []BackwardCompatibilityRule{}
+	for _, operationType := range []string{"callback", "webhook"} {
+		for _, rule := range rules {
+			switch {
+			case hasAnyPrefix(rule.Id, invertedResponseRulePrefixes):
+				result = append(result, newInvertedOperationRule(operationType, "request", rule))
+			case hasAnyPrefix(rule.Id, invertedRequestRulePrefixes):
+				result = append(result, newInvertedOperationRule(operationType, "response", rule))
+			}
+		}
+	}
+	return result
+}
+
+// newInvertedOperationRule returns the rule of an error found in the request body or in a response of a callback or webhook operation
+func newInvertedOperationRule(operationType string, operationPart string, rule BackwardCompatibilityRule) BackwardCompatibilityRule {
+	return BackwardCompatibilityRule{
+		Id:          operationType + "-" + rule.Id,
+		Level:       rule.Level,
+		Description: fmt.Sprintf(rulesLocalizer.Get("descriptions.inverted-"+operationType+"-"+operationPart), rule.Description),
+	}
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func getRulesById(rules []BackwardCompatibilityRule) map[string]BackwardCompatibilityRule {
+	result := make(map[string]BackwardCompatibilityRule, len(rules))
+	for _, rule := range rules {
+		result[rule.Id] = rule
+	}
+	return result
+}
+
+// GetRule returns the rule with the given id
+func GetRule(id string) (BackwardCompatibilityRule, bool) {
+	rule, ok := rulesById[id]
+	return rule, ok
+}
@@ -0,0 +1,81 @@
+package checker_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+)
+
+func TestRules_UniqueIds(t *testing.T) {
+	ids := utils.StringSet{}
+	for _, rule := range checker.GetAllRules() {
+		require.False(t, ids.Contains(rule.Id), rule.Id)
+		// the localizer returns the key of a missing description
+		require.NotContains(t, rule.Description, "descriptions.", rule.Id)
+		ids.Add(rule.Id)
+	}
+}
+
+// getFixtures returns the specs of the test data grouped by directory, files which aren't valid specs are skipped
+func getFixtures(t *testing.T) map[string][]*load.SpecInfo {
+	t.Helper()
+
+	result := map[string][]*load.SpecInfo{}
+	err := filepath.WalkDir("../data", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" && ext != ".json" {
+			return nil
+		}
+		spec, err := openOpenAPI31(path)
+		if err != nil || spec.Spec == nil || spec.Spec.OpenAPI == "" {
+			return nil
+		}
+		dir := filepath.Dir(path)
+		result[dir] = append(result[dir], spec)
+		return nil
+	})
+	require.NoError(t, err)
+	return result
+}
+
+// TestRules_AllErrorsHaveRules runs all the checks between every pair of specs in each directory of the test data
+func TestRules_AllErrorsHaveRules(t *testing.T) {
+	c := checker.GetAllChecks(utils.StringList{})
+	matchOperationIdsConfig := getConfig()
+	matchOperationIdsConfig.MatchOperationIds = true
+
+	for _, specs := range getFixtures(t) {
+		for _, s1 := range specs {
+			for _, s2 := range specs {
+				for _, diffConfig := range []*diff.Config{getConfig(), matchOperationIdsConfig} {
+					d, osm, err := diff.GetWithOperationsSourcesMap(diffConfig, s1, s2)
+					if err != nil {
+						// some fixtures are invalid on purpose, like specs with duplicate endpoints
+						continue
+					}
+					for _, bcErr := range checker.CheckBackwardCompatibilityUntilLevel(c, d, osm, checker.INFO) {
+						_, ok := checker.GetRule(bcErr.Id)
+						require.True(t, ok, "%s: %s -> %s", bcErr.Id, s1.Url, s2.Url)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestRules_GetRule(t *testing.T) {
+	rule, ok := checker.GetRule("request-parameter-removed")
+	require.True(t, ok)
+	require.Equal(t, checker.WARN, rule.Level)
+
+	_, ok = checker.GetRule("xxx")
+	require.False(t, ok)
+}
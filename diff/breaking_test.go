@@ -6,6 +6,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/utils"
 )
 
 // BC: deleting a path is breaking
@@ -272,3 +273,22 @@ func TestBreaking_OptionalResponseHeaderRemoved(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, d)
 }
+
+// BC: deleting a media type from the request body is breaking
+func TestBreaking_RequestBodyMediaTypeDeleted(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/media-types/base.yaml")
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile("../data/media-types/revision.yaml")
+	require.NoError(t, err)
+
+	d, err := diff.Get(&diff.Config{
+		BreakingOnly: true,
+	}, s1, s2)
+	require.NoError(t, err)
+	contentDiff := d.PathsDiff.Modified["/pets"].OperationsDiff.Modified["POST"].RequestBodyDiff.ContentDiff
+	require.Equal(t, utils.StringList{"text/plain"}, contentDiff.MediaTypeDeleted)
+	require.Empty(t, contentDiff.MediaTypeAdded)
+}
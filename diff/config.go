@@ -13,10 +13,12 @@ type Config struct {
 	PathPrefixRevision      string
 	PathStripPrefixBase     string
 	PathStripPrefixRevision string
+	PathRewriteRules        RewriteRules // rewrite paths before they are matched, applied before the prefixes are stripped and prepended
 	BreakingOnly            bool
 	DeprecationDays         int
 	ExcludeElements         utils.StringSet
 	MatchPathParams         bool
+	MatchOperationIds       bool // match operations whose endpoint changed by their operationId
 }
 
 const (
@@ -87,6 +89,7 @@ const (
 	SunsetExtension          = "x-sunset"
 	XStabilityLevelExtension = "x-stability-level"
 	XExtensibleEnumExtension = "x-extensible-enum"
+	XRenamedFromExtension    = "x-renamed-from"
 )
 
 func (config *Config) WithCheckBreaking() *Config {
@@ -34,19 +34,14 @@ func (diff *ContentDiff) Empty() bool {
 		len(diff.MediaTypeModified) == 0
 }
 
-func (diff *ContentDiff) removeNonBreaking(state *state) {
+func (diff *ContentDiff) removeNonBreaking() {
 	if diff.Empty() {
 		return
 	}
 
-	switch state.direction {
-	case directionRequest:
-		// if this is part of the request, then media-types can be deleted without breaking the client
-		diff.MediaTypeDeleted = nil
-	case directionResponse:
-		// if this is part of the response, then media-types can be added without breaking the client
-		diff.MediaTypeAdded = nil
-	}
+	// media-types can be added without breaking the client, both in requests and in responses
+	// deleting a media-type breaks clients which send it in a request, or which expect it in a response
+	diff.MediaTypeAdded = nil
 }
 
 func getContentDiff(config *Config, state *state, content1, content2 openapi3.Content) (*ContentDiff, error) {
@@ -56,7 +51,7 @@ func getContentDiff(config *Config, state *state, content1, content2 openapi3.Co
 	}
 
 	if config.BreakingOnly {
-		diff.removeNonBreaking(state)
+		diff.removeNonBreaking()
 	}
 
 	if diff.Empty() {
@@ -0,0 +1,94 @@
+package diff
+
+import (
+	"github.com/tufin/oasdiff/utils"
+)
+
+// DependentRequiredDiff describes the changes between a pair of JSON Schema 2020-12 dependentRequired keywords: https://json-schema.org/draft/2020-12/json-schema-validation#name-dependentrequired
+// Added and Deleted list the properties whose dependencies were added or deleted, Modified lists the changes in the dependencies of each property
+type DependentRequiredDiff struct {
+	Added    utils.StringList     `json:"added,omitempty" yaml:"added,omitempty"`
+	Deleted  utils.StringList     `json:"deleted,omitempty" yaml:"deleted,omitempty"`
+	Modified ModifiedDependencies `json:"modified,omitempty" yaml:"modified,omitempty"`
+}
+
+// ModifiedDependencies maps properties to the changes in their dependencies
+type ModifiedDependencies map[string]*StringsDiff
+
+// Empty indicates whether a change was found in this element
+func (diff *DependentRequiredDiff) Empty() bool {
+	if diff == nil {
+		return true
+	}
+
+	return len(diff.Added) == 0 &&
+		len(diff.Deleted) == 0 &&
+		len(diff.Modified) == 0
+}
+
+func getDependentRequiredDiff(config *Config, state *state, dependencies1, dependencies2 map[string]utils.StringList) *DependentRequiredDiff {
+	diff := getDependentRequiredDiffInternal(dependencies1, dependencies2)
+
+	if config.BreakingOnly {
+		diff.removeNonBreaking(state)
+	}
+
+	if diff.Empty() {
+		return nil
+	}
+
+	return diff
+}
+
+func getDependentRequiredDiffInternal(dependencies1, dependencies2 map[string]utils.StringList) *DependentRequiredDiff {
+	result := DependentRequiredDiff{
+		Added:    utils.StringList{},
+		Deleted:  utils.StringList{},
+		Modified: ModifiedDependencies{},
+	}
+
+	for property, required1 := range dependencies1 {
+		required2, ok := dependencies2[property]
+		if !ok {
+			result.Deleted = append(result.Deleted, property)
+			continue
+		}
+		if stringsDiff := getStringsDiff(required1, required2); !stringsDiff.Empty() {
+			result.Modified[property] = stringsDiff
+		}
+	}
+
+	for property := range dependencies2 {
+		if _, ok := dependencies1[property]; !ok {
+			result.Added = append(result.Added, property)
+		}
+	}
+
+	return &result
+}
+
+// removeNonBreaking keeps only the new dependencies in requests, and the removed dependencies in responses
+func (diff *DependentRequiredDiff) removeNonBreaking(state *state) {
+	if diff.Empty() {
+		return
+	}
+
+	switch state.direction {
+	case directionRequest:
+		diff.Deleted = nil
+	case directionResponse:
+		diff.Added = nil
+	}
+
+	for property, stringsDiff := range diff.Modified {
+		switch state.direction {
+		case directionRequest:
+			stringsDiff.Deleted = nil
+		case directionResponse:
+			stringsDiff.Added = nil
+		}
+		if stringsDiff.Empty() {
+			delete(diff.Modified, property)
+		}
+	}
+}
@@ -18,15 +18,18 @@ var (
 
 // Diff describes the changes between a pair of OpenAPI objects: https://swagger.io/specification/#schema
 type Diff struct {
-	ExtensionsDiff   *ExtensionsDiff           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
-	OpenAPIDiff      *ValueDiff                `json:"openAPI,omitempty" yaml:"openAPI,omitempty"`
-	InfoDiff         *InfoDiff                 `json:"info,omitempty" yaml:"info,omitempty"`
-	PathsDiff        *PathsDiff                `json:"paths,omitempty" yaml:"paths,omitempty"`
-	EndpointsDiff    *EndpointsDiff            `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
-	SecurityDiff     *SecurityRequirementsDiff `json:"security,omitempty" yaml:"security,omitempty"`
-	ServersDiff      *ServersDiff              `json:"servers,omitempty" yaml:"servers,omitempty"`
-	TagsDiff         *TagsDiff                 `json:"tags,omitempty" yaml:"tags,omitempty"`
-	ExternalDocsDiff *ExternalDocsDiff         `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	ExtensionsDiff        *ExtensionsDiff           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+	OpenAPIDiff           *ValueDiff                `json:"openAPI,omitempty" yaml:"openAPI,omitempty"`
+	InfoDiff              *InfoDiff                 `json:"info,omitempty" yaml:"info,omitempty"`
+	PathsDiff             *PathsDiff                `json:"paths,omitempty" yaml:"paths,omitempty"`
+	EndpointsDiff         *EndpointsDiff            `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
+	RenamedOperationsDiff *RenamedOperationsDiff    `json:"renamedOperations,omitempty" yaml:"renamedOperations,omitempty"`
+	PathRewrites          *PathRewrites             `json:"pathRewrites,omitempty" yaml:"pathRewrites,omitempty"`
+	WebhooksDiff          *PathsDiff                `json:"webhooks,omitempty" yaml:"webhooks,omitempty"`
+	SecurityDiff          *SecurityRequirementsDiff `json:"security,omitempty" yaml:"security,omitempty"`
+	ServersDiff           *ServersDiff              `json:"servers,omitempty" yaml:"servers,omitempty"`
+	TagsDiff              *TagsDiff                 `json:"tags,omitempty" yaml:"tags,omitempty"`
+	ExternalDocsDiff      *ExternalDocsDiff         `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
 
 	ComponentsDiff `json:"components,omitempty" yaml:"components,omitempty"`
 }
@@ -64,9 +67,11 @@ Get calculates the diff between a pair of OpenAPI objects.
 Note that Get expects OpenAPI References (https://swagger.io/docs/specification/using-ref/) to be resolved.
 References are normally resolved automatically when you load the spec.
 In other cases you can resolve refs using https://pkg.go.dev/github.com/getkin/kin-openapi/openapi3#Loader.ResolveRefsIn.
+
+The webhooks of OpenAPI 3.1 specs are parsed when the specs are loaded into load.SpecInfo, so Get doesn't compare them, see GetWithOperationsSourcesMap.
 */
 func Get(config *Config, s1, s2 *openapi3.T) (*Diff, error) {
-	diff, err := getDiff(config, newState(), s1, s2)
+	diff, err := getDiff(config, newState(), s1, s2, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +86,7 @@ References are normally resolved automatically when you load the spec.
 In other cases you can resolve refs using https://pkg.go.dev/github.com/getkin/kin-openapi/openapi3#Loader.ResolveRefsIn.
 */
 func GetWithOperationsSourcesMap(config *Config, s1, s2 *load.SpecInfo) (*Diff, *OperationsSourcesMap, error) {
-	diff, err := getDiff(config, newState(), s1.Spec, s2.Spec)
+	diff, err := getDiff(config, newState(), s1.Spec, s2.Spec, s1.Webhooks, s2.Webhooks)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -99,6 +104,13 @@ func GetWithOperationsSourcesMap(config *Config, s1, s2 *load.SpecInfo) (*Diff,
 	for k, v := range *operationsSources2 {
 		operationsSources[k] = v
 	}
+
+	for _, s := range []*load.SpecInfo{s1, s2} {
+		if _, err := mergedWebhooks([]load.SpecInfo{*s}, operationsSources); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return diff, &operationsSources, nil
 }
 
@@ -128,22 +140,44 @@ func GetPathsDiff(config *Config, s1, s2 []load.SpecInfo) (*Diff, *OperationsSou
 		return nil, nil, err
 	}
 
-	if result.PathsDiff, err = getPathsDiff(config, state, *paths1, *paths2); err != nil {
-		return nil, nil, err
+	pathsBase, pathsRevision := *paths1, *paths2
+	if config.MatchOperationIds {
+		if result.RenamedOperationsDiff, pathsBase, pathsRevision, err = getRenamedOperationsDiff(config, state, pathsBase, pathsRevision); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	if result.EndpointsDiff, err = getEndpointsDiff(config, state, *paths1, *paths2); err != nil {
+	if result.PathsDiff, err = getPathsDiff(config, state, pathsBase, pathsRevision); err != nil {
 		return nil, nil, err
 	}
 
-	if result.Empty() {
-		return nil, nil, nil
+	if result.EndpointsDiff, err = getEndpointsDiff(config, state, pathsBase, pathsRevision); err != nil {
+		return nil, nil, err
 	}
 
+	result.PathRewrites = getPathRewrites(config, *paths1, *paths2, result)
+
 	operationsSources := *operationsSources1
 	for k, v := range *operationsSources2 {
 		operationsSources[k] = v
 	}
+
+	webhooks1, err := mergedWebhooks(s1, operationsSources)
+	if err != nil {
+		return nil, nil, err
+	}
+	webhooks2, err := mergedWebhooks(s2, operationsSources)
+	if err != nil {
+		return nil, nil, err
+	}
+	if result.WebhooksDiff, err = getWebhooksDiff(config, state, webhooks1, webhooks2); err != nil {
+		return nil, nil, err
+	}
+
+	if result.Empty() {
+		return nil, nil, nil
+	}
+
 	return result, &operationsSources, nil
 }
 
@@ -201,6 +235,26 @@ func mergedPaths(s1 []load.SpecInfo, matchPathParams bool) (*openapi3.Paths, *Op
 	return &result, &operationsSources, nil
 }
 
+// mergedWebhooks merges the webhooks of the specs and adds their operations to the sources map
+// unlike paths, webhooks have no x-since-date extension to choose between, so a webhook defined in several specs is an error
+func mergedWebhooks(specs []load.SpecInfo, operationsSources OperationsSourcesMap) (openapi3.Paths, error) {
+	result := openapi3.Paths{}
+	sources := map[string]string{}
+	for _, s := range specs {
+		for name, pathItem := range s.Webhooks {
+			if source, ok := sources[name]; ok {
+				return nil, fmt.Errorf("duplicate webhook %q found in %s and %s", name, source, s.Url)
+			}
+			result[name] = pathItem
+			sources[name] = s.Url
+			for _, operation := range pathItem.Operations() {
+				operationsSources[operation] = s.Url
+			}
+		}
+	}
+	return result, nil
+}
+
 func sinceDateFrom(pathItem openapi3.PathItem, operation openapi3.Operation) (civil.Date, error) {
 	since, _, err := getSinceDate(pathItem.Extensions)
 	if err != nil {
@@ -239,13 +293,13 @@ func getSinceDate(extensions map[string]interface{}) (civil.Date, bool, error) {
 	return date, true, nil
 }
 
-func getDiff(config *Config, state *state, s1, s2 *openapi3.T) (*Diff, error) {
+func getDiff(config *Config, state *state, s1, s2 *openapi3.T, webhooks1, webhooks2 openapi3.Paths) (*Diff, error) {
 
 	if s1 == nil || s2 == nil {
 		return nil, errors.New("spec is nil")
 	}
 
-	diff, err := getDiffInternal(config, state, s1, s2)
+	diff, err := getDiffInternal(config, state, s1, s2, webhooks1, webhooks2)
 	if err != nil {
 		return nil, err
 	}
@@ -261,20 +315,36 @@ func getDiff(config *Config, state *state, s1, s2 *openapi3.T) (*Diff, error) {
 	return diff, nil
 }
 
-func getDiffInternal(config *Config, state *state, s1, s2 *openapi3.T) (*Diff, error) {
+func getDiffInternal(config *Config, state *state, s1, s2 *openapi3.T, webhooks1, webhooks2 openapi3.Paths) (*Diff, error) {
 
 	result := newDiff()
 	var err error
 
+	state.securityBase = &s1.Security
+	state.securityRevision = &s2.Security
+
 	result.ExtensionsDiff = getExtensionsDiff(config, state, s1.Extensions, s2.Extensions)
 	result.OpenAPIDiff = getValueDiff(s1.OpenAPI, s2.OpenAPI)
 	result.InfoDiff = getInfoDiff(config, state, s1.Info, s2.Info)
 
-	if result.PathsDiff, err = getPathsDiff(config, state, s1.Paths, s2.Paths); err != nil {
+	paths1, paths2 := s1.Paths, s2.Paths
+	if config.MatchOperationIds {
+		if result.RenamedOperationsDiff, paths1, paths2, err = getRenamedOperationsDiff(config, state, paths1, paths2); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.PathsDiff, err = getPathsDiff(config, state, paths1, paths2); err != nil {
 		return nil, err
 	}
 
-	if result.EndpointsDiff, err = getEndpointsDiff(config, state, s1.Paths, s2.Paths); err != nil {
+	if result.EndpointsDiff, err = getEndpointsDiff(config, state, paths1, paths2); err != nil {
+		return nil, err
+	}
+
+	result.PathRewrites = getPathRewrites(config, s1.Paths, s2.Paths, result)
+
+	if result.WebhooksDiff, err = getWebhooksDiff(config, state, webhooks1, webhooks2); err != nil {
 		return nil, err
 	}
 
@@ -303,6 +373,7 @@ func (diff *Diff) GetSummary() *Summary {
 
 	// swagger
 	summary.add(diff.PathsDiff, PathsDetail)
+	summary.add(diff.WebhooksDiff, WebhooksDetail)
 	summary.add(diff.SecurityDiff, SecurityDetail)
 	summary.add(diff.ServersDiff, ServersDetail)
 	summary.add(diff.TagsDiff, TagsDetail)
@@ -320,6 +391,7 @@ func (diff *Diff) GetSummary() *Summary {
 
 	// special
 	summary.add(diff.EndpointsDiff, EndpointsDetail)
+	summary.add(diff.RenamedOperationsDiff, RenamedOperationsDetail)
 	return summary
 }
 
@@ -0,0 +1,155 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+)
+
+func loadOpenAPI31(t *testing.T, file string) *openapi3.T {
+	t.Helper()
+	loader := openapi3.NewLoader()
+	loader.ReadFromURIFunc = load.ReadFromURI
+	specInfo, err := load.LoadSpecInfoFromFile(loader, file)
+	require.NoError(t, err)
+	return specInfo.Spec
+}
+
+func getJSONSchemaKeywordsDiff(t *testing.T, config *diff.Config) *diff.SchemaDiff {
+	t.Helper()
+	d, err := diff.Get(config,
+		loadOpenAPI31(t, "../data/openapi31/schema-keywords-base.yaml"),
+		loadOpenAPI31(t, "../data/openapi31/schema-keywords-revision.yaml"))
+	require.NoError(t, err)
+	return d.ComponentsDiff.SchemasDiff.Modified["Pet"]
+}
+
+func TestJSONSchema_TypeArrayNullable(t *testing.T) {
+	nameDiff := getJSONSchemaKeywordsDiff(t, diff.NewConfig()).PropertiesDiff.Modified["name"]
+	require.Nil(t, nameDiff.TypeDiff)
+	require.Equal(t, &diff.ValueDiff{From: true, To: false}, nameDiff.NullableDiff)
+}
+
+func TestJSONSchema_TypeArrayEquivalentToNullable(t *testing.T) {
+	loader := openapi3.NewLoader()
+	s1, err := loader.LoadFromData([]byte(`
+openapi: 3.0.0
+info:
+  title: test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    test:
+      type: string
+      nullable: true
+`))
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromData(load.NormalizeOpenAPI31([]byte(`
+openapi: 3.0.0
+info:
+  title: test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    test:
+      type: [string, "null"]
+`)))
+	require.NoError(t, err)
+
+	d, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Nil(t, d)
+}
+
+func TestJSONSchema_TypeArrays(t *testing.T) {
+	require.Equal(t,
+		&diff.StringsDiff{Added: utils.StringList{"boolean"}, Deleted: utils.StringList{}},
+		getJSONSchemaKeywordsDiff(t, diff.NewConfig()).PropertiesDiff.Modified["id"].TypesDiff)
+}
+
+func TestJSONSchema_ExclusiveMinimum(t *testing.T) {
+	ageDiff := getJSONSchemaKeywordsDiff(t, diff.NewConfig()).PropertiesDiff.Modified["age"]
+	require.Equal(t, &diff.ValueDiff{From: 0.0, To: 1.0}, ageDiff.MinDiff)
+	require.Nil(t, ageDiff.ExclusiveMinDiff)
+}
+
+func TestJSONSchema_Const(t *testing.T) {
+	require.Equal(t,
+		&diff.ValueDiff{From: "dog", To: "cat"},
+		getJSONSchemaKeywordsDiff(t, diff.NewConfig()).PropertiesDiff.Modified["kind"].ConstDiff)
+}
+
+func TestJSONSchema_PrefixItems(t *testing.T) {
+	prefixItemsDiff := getJSONSchemaKeywordsDiff(t, diff.NewConfig()).PropertiesDiff.Modified["position"].PrefixItemsDiff
+	require.Equal(t, 1, prefixItemsDiff.Added)
+	require.Equal(t, 0, prefixItemsDiff.Deleted)
+	// the reference in the second item is resolved
+	require.Equal(t,
+		&diff.ValueDiff{From: "number", To: "string"},
+		prefixItemsDiff.Modified["#2"].PropertiesDiff.Modified["value"].TypeDiff)
+}
+
+func TestJSONSchema_DependentRequired(t *testing.T) {
+	dependentRequiredDiff := getJSONSchemaKeywordsDiff(t, diff.NewConfig()).DependentRequiredDiff
+	require.Equal(t, utils.StringList{"phone"}, dependentRequiredDiff.Added)
+	require.Empty(t, dependentRequiredDiff.Deleted)
+	require.Equal(t, utils.StringList{"license"}, dependentRequiredDiff.Modified["owner"].Added)
+}
+
+func TestJSONSchema_IfThenElse(t *testing.T) {
+	schemaDiff := getJSONSchemaKeywordsDiff(t, diff.NewConfig())
+	require.Equal(t, &diff.ValueDiff{From: "dog", To: "cat"}, schemaDiff.IfDiff.PropertiesDiff.Modified["kind"].ConstDiff)
+	require.Equal(t, &diff.ValueDiff{From: "string", To: "integer"}, schemaDiff.ThenDiff.PropertiesDiff.Modified["license"].TypeDiff)
+	require.Equal(t, utils.StringList{"phone"}, schemaDiff.ElseDiff.RequiredDiff.Added)
+}
+
+func TestJSONSchema_Defs(t *testing.T) {
+	require.Equal(t,
+		&diff.ValueDiff{From: "string", To: "integer"},
+		getJSONSchemaKeywordsDiff(t, diff.NewConfig()).DefsDiff.Modified["tag"].TypeDiff)
+}
+
+func TestJSONSchema_UnevaluatedProperties(t *testing.T) {
+	require.Equal(t,
+		&diff.ValueDiff{From: false, To: true},
+		getJSONSchemaKeywordsDiff(t, diff.NewConfig()).UnevaluatedPropertiesAllowedDiff)
+}
+
+func TestJSONSchema_BreakingOnly(t *testing.T) {
+	d, err := diff.Get(&diff.Config{BreakingOnly: true},
+		loadOpenAPI31(t, "../data/openapi31/schema-keywords-base.yaml"),
+		loadOpenAPI31(t, "../data/openapi31/schema-keywords-revision.yaml"))
+	require.NoError(t, err)
+
+	requestSchemaDiff := d.PathsDiff.Modified["/pets"].OperationsDiff.Modified["POST"].RequestBodyDiff.ContentDiff.MediaTypeModified["application/json"].SchemaDiff
+
+	// new dependencies break clients which send the request
+	require.Equal(t, utils.StringList{"phone"}, requestSchemaDiff.DependentRequiredDiff.Added)
+	// allowing unevaluated properties doesn't break clients
+	require.Nil(t, requestSchemaDiff.UnevaluatedPropertiesAllowedDiff)
+}
+
+func TestJSONSchema_NotLoaded(t *testing.T) {
+	// specs which weren't loaded into a load.SpecInfo keep the raw subschemas, which are ignored and left as they are
+	loader := openapi3.NewLoader()
+	loader.ReadFromURIFunc = load.ReadFromURI
+	s1, err := loader.LoadFromFile("../data/openapi31/schema-keywords-base.yaml")
+	require.NoError(t, err)
+	s2, err := loader.LoadFromFile("../data/openapi31/schema-keywords-revision.yaml")
+	require.NoError(t, err)
+
+	d, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	petDiff := d.ComponentsDiff.SchemasDiff.Modified["Pet"]
+	require.Nil(t, petDiff.ThenDiff)
+	require.NotNil(t, petDiff.DependentRequiredDiff)
+	require.IsType(t, map[string]interface{}{}, s1.Components.Schemas["Pet"].Value.Extensions[load.KeywordThen])
+}
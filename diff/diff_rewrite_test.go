@@ -0,0 +1,137 @@
+package diff_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/diff"
+)
+
+func getRewriteFile(file string) string {
+	return fmt.Sprintf("../data/rewrite/%s", file)
+}
+
+func getRewriteDiff(t *testing.T, rules diff.RewriteRules) *diff.Diff {
+	t.Helper()
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile(getRewriteFile("base.yaml"))
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile(getRewriteFile("revision.yaml"))
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.PathRewriteRules = rules
+	dd, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	return dd
+}
+
+func TestRewrite_NoRules(t *testing.T) {
+	dd := getRewriteDiff(t, nil)
+	require.ElementsMatch(t, []string{"/api/v1/pets", "/api/v1/pets/{id}", "/pets/{id}/owner"}, dd.PathsDiff.Deleted)
+	require.Nil(t, dd.PathRewrites)
+}
+
+func TestRewrite_RulesFile(t *testing.T) {
+	rules, err := diff.LoadRewriteRules(getRewriteFile("rules.yaml"))
+	require.NoError(t, err)
+
+	dd := getRewriteDiff(t, rules)
+	require.Empty(t, dd.PathsDiff.Deleted)
+	require.Empty(t, dd.PathsDiff.Added)
+	require.Contains(t, dd.PathsDiff.Modified, "/pets/{id}")
+	require.Contains(t, dd.PathsDiff.Modified, "/owners/by-pet/{id}")
+
+	// only the paths which appear in the diff are listed
+	require.Equal(t, diff.PathRewrites{
+		"/pets/{id}": {
+			Base: &diff.RewrittenPath{Path: "/api/v1/pets/{id}", Rule: "drop the version prefix"},
+		},
+		"/owners/by-pet/{id}": {
+			Base: &diff.RewrittenPath{Path: "/pets/{id}/owner", Rule: "path /pets/{id}/owner -> /owners/by-pet/{id}"},
+		},
+	}, *dd.PathRewrites)
+}
+
+func TestRewrite_CaptureGroups(t *testing.T) {
+	rule, err := diff.NewRegexRewriteRule(diff.RewriteSpecBase, `^/api/v(\d+)/pets`, "/pets/v$1")
+	require.NoError(t, err)
+
+	dd := getRewriteDiff(t, diff.RewriteRules{rule})
+	require.Contains(t, dd.PathsDiff.Deleted, "/pets/v1/{id}")
+	require.Equal(t, "/api/v1/pets/{id}", (*dd.PathRewrites)["/pets/v1/{id}"].Base.Path)
+}
+
+func TestRewrite_FirstMatchingRule(t *testing.T) {
+	rule1, err := diff.NewRegexRewriteRule("", `^/api/v1/`, "/")
+	require.NoError(t, err)
+	rule2, err := diff.NewRegexRewriteRule("", `^/api/`, "/other/")
+	require.NoError(t, err)
+
+	dd := getRewriteDiff(t, diff.RewriteRules{rule1, rule2})
+	require.Contains(t, dd.PathsDiff.Modified, "/pets/{id}")
+	require.Equal(t, `regex ^/api/v1/ -> /`, (*dd.PathRewrites)["/pets/{id}"].Base.Rule)
+}
+
+func TestRewrite_RevisionOnly(t *testing.T) {
+	rule, err := diff.NewRegexRewriteRule(diff.RewriteSpecRevision, `^/`, "/api/v1/")
+	require.NoError(t, err)
+
+	dd := getRewriteDiff(t, diff.RewriteRules{rule})
+	require.Contains(t, dd.PathsDiff.Modified, "/api/v1/pets/{id}")
+	require.Nil(t, (*dd.PathRewrites)["/api/v1/pets/{id}"].Base)
+	require.Equal(t, "/pets/{id}", (*dd.PathRewrites)["/api/v1/pets/{id}"].Revision.Path)
+}
+
+func TestRewrite_BeforePrefix(t *testing.T) {
+	rule, err := diff.NewRegexRewriteRule(diff.RewriteSpecBase, `^/api/v1/`, "/")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.PathRewriteRules = diff.RewriteRules{rule}
+	config.PathPrefixBase = "/prefix"
+
+	loader := openapi3.NewLoader()
+	s1, err := loader.LoadFromFile(getRewriteFile("base.yaml"))
+	require.NoError(t, err)
+	s2, err := loader.LoadFromFile(getRewriteFile("revision.yaml"))
+	require.NoError(t, err)
+
+	dd, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	require.Contains(t, dd.PathsDiff.Deleted, "/prefix/pets/{id}")
+}
+
+func TestRewrite_BreakingOnly(t *testing.T) {
+	rules, err := diff.LoadRewriteRules(getRewriteFile("rules.yaml"))
+	require.NoError(t, err)
+
+	loader := openapi3.NewLoader()
+	s1, err := loader.LoadFromFile(getRewriteFile("base.yaml"))
+	require.NoError(t, err)
+	s2, err := loader.LoadFromFile(getRewriteFile("revision.yaml"))
+	require.NoError(t, err)
+
+	// the deleted path is reported with its rewritten name
+	config := diff.NewConfig()
+	config.PathRewriteRules = diff.RewriteRules{rules[0], {Path: "/pets/{id}/owner", Replacement: "/pets/{id}/owners"}}
+	config.BreakingOnly = true
+	dd, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/pets/{id}/owners"}, []string(dd.PathsDiff.Deleted))
+}
+
+func TestParseRewriteRules_Invalid(t *testing.T) {
+	_, err := diff.ParseRewriteRules([]byte("- regex: ^/api\n  path: /api\n  replacement: /\n"))
+	require.EqualError(t, err, "entry 1: rule must have either a path or a regex")
+
+	_, err = diff.ParseRewriteRules([]byte("- regex: (\n  replacement: /\n"))
+	require.ErrorContains(t, err, "entry 1: failed to compile regex \"(\"")
+
+	_, err = diff.ParseRewriteRules([]byte("- spec: other\n  path: /api\n  replacement: /\n"))
+	require.EqualError(t, err, "entry 1: invalid spec \"other\", must be \"base\" or \"revision\"")
+}
@@ -627,6 +627,28 @@ func TestSecurityRequirementModified(t *testing.T) {
 	require.NotEmpty(t, dd)
 }
 
+func TestSecurityRequirementInheritedFromGlobal(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/security/base.yaml")
+	require.NoError(t, err)
+
+	s2, err := openapi3.NewLoader().LoadFromFile("../data/security/base.yaml")
+	require.NoError(t, err)
+
+	s1.Security = *openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("apiKey"))
+	s2.Security = *openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("apiKey"))
+	s2.Paths["/pets"].Get.Security = openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("bearerAuth").Authenticate("apiKey"))
+
+	dd, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	securityDiff := dd.PathsDiff.Modified["/pets"].OperationsDiff.Modified["GET"].SecurityDiff
+	require.Equal(t, utils.StringList{"apiKey AND bearerAuth"}, securityDiff.Added)
+	require.Equal(t, utils.StringList{"apiKey"}, securityDiff.Deleted)
+	require.Equal(t, &s1.Security, securityDiff.Base)
+}
+
 func TestAddedSecurityOAuthFlows(t *testing.T) {
 	require.True(t,
 		d(t, diff.NewConfig(), 1, 5).ComponentsDiff.SecuritySchemesDiff.Modified["AccessToken"].OAuthFlowsDiff.Added)
@@ -644,12 +666,126 @@ func TestOAS31(t *testing.T) {
 	d, err := diff.Get(diff.NewConfig(), s1, s2)
 	require.NoError(t, err)
 
-	// while specific 3.1 features, such as webhooks, are not yet supported by kin-openapi, the diff still works
+	// while specific 3.1 features are not yet supported by kin-openapi, the diff still works
 	require.Contains(t,
 		d.ComponentsDiff.SchemasDiff.Modified["Pet"].RequiredDiff.Added,
 		"tag")
 }
 
+func loadSpecInfo(t *testing.T, file string) *load.SpecInfo {
+	t.Helper()
+	specInfo, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), file)
+	require.NoError(t, err)
+	return specInfo
+}
+
+func TestOAS31_Webhooks(t *testing.T) {
+	d, _, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(),
+		loadSpecInfo(t, "../data/openapi31-test1.yaml"),
+		loadSpecInfo(t, "../data/openapi31-test2.yaml"))
+	require.NoError(t, err)
+
+	require.Contains(t,
+		d.WebhooksDiff.Modified["newPet"].OperationsDiff.Modified["POST"].RequestBodyDiff.ContentDiff.MediaTypeModified["application/json"].SchemaDiff.RequiredDiff.Added,
+		"tag")
+	require.Equal(t, diff.SummaryDetails{0, 0, 1}, d.GetSummary().GetSummaryDetails(diff.WebhooksDetail))
+}
+
+func TestWebhooks_AddedAndDeleted(t *testing.T) {
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(),
+		loadSpecInfo(t, "../data/webhooks/spec_1.yaml"),
+		loadSpecInfo(t, "../data/openapi31-test1.yaml"))
+	require.NoError(t, err)
+
+	require.Equal(t, utils.StringList{"deletedPet"}, d.WebhooksDiff.Deleted)
+	require.Empty(t, d.WebhooksDiff.Added)
+	require.Equal(t, "../data/webhooks/spec_1.yaml", (*osm)[d.WebhooksDiff.Base["deletedPet"].Post])
+}
+
+func TestWebhooks_Composed(t *testing.T) {
+	s1 := loadSpecInfo(t, "../data/webhooks/spec_1.yaml")
+	s2 := loadSpecInfo(t, "../data/openapi31-test2.yaml")
+
+	d, osm, err := diff.GetPathsDiff(diff.NewConfig(), []load.SpecInfo{*s1}, []load.SpecInfo{*s2})
+	require.NoError(t, err)
+
+	require.Equal(t, utils.StringList{"deletedPet"}, d.WebhooksDiff.Deleted)
+	require.Contains(t, d.WebhooksDiff.Modified, "newPet")
+	require.Equal(t, "../data/openapi31-test2.yaml", (*osm)[d.WebhooksDiff.Revision["newPet"].Post])
+}
+
+func TestWebhooks_ComposedDuplicate(t *testing.T) {
+	s1 := loadSpecInfo(t, "../data/webhooks/spec_1.yaml")
+	s2 := loadSpecInfo(t, "../data/openapi31-test2.yaml")
+
+	_, _, err := diff.GetPathsDiff(diff.NewConfig(), []load.SpecInfo{*s1}, []load.SpecInfo{*s1, *s2})
+	require.EqualError(t, err, `duplicate webhook "newPet" found in ../data/webhooks/spec_1.yaml and ../data/openapi31-test2.yaml`)
+}
+
+func TestWebhooks_Get(t *testing.T) {
+	// the webhooks are parsed when the specs are loaded into load.SpecInfo, so Get doesn't compare them
+	d, err := diff.Get(diff.NewConfig(),
+		loadSpecInfo(t, "../data/webhooks/spec_1.yaml").Spec,
+		loadSpecInfo(t, "../data/openapi31-test1.yaml").Spec)
+	require.NoError(t, err)
+	require.Nil(t, d.WebhooksDiff)
+}
+
+func TestWebhooks_None(t *testing.T) {
+	require.Nil(t, d(t, diff.NewConfig(), 1, 3).WebhooksDiff)
+}
+
+func getOperationIdsDiff(t *testing.T, matchOperationIds bool) *diff.Diff {
+	t.Helper()
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/operation-ids/base.yaml")
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile("../data/operation-ids/revision.yaml")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.MatchOperationIds = matchOperationIds
+	d, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	return d
+}
+
+func TestMatchOperationIds(t *testing.T) {
+	d := getOperationIdsDiff(t, true)
+
+	require.Len(t, *d.RenamedOperationsDiff, 2)
+
+	getUser := (*d.RenamedOperationsDiff)["getUser"]
+	require.Equal(t, diff.Endpoint{Method: "GET", Path: "/v1/users/{id}"}, getUser.Base)
+	require.Equal(t, diff.Endpoint{Method: "GET", Path: "/users/{userId}"}, getUser.Revision)
+	require.Equal(t, utils.StringList{"fields"}, getUser.MethodDiff.ParametersDiff.Added["query"])
+
+	deleteUser := (*d.RenamedOperationsDiff)["deleteUser"]
+	require.Equal(t, diff.Endpoint{Method: "DELETE", Path: "/v1/users/{id}"}, deleteUser.Base)
+	require.Equal(t, diff.Endpoint{Method: "POST", Path: "/users/{userId}/remove"}, deleteUser.Revision)
+
+	// matched operations aren't reported as deleted and added endpoints
+	require.Empty(t, d.EndpointsDiff.Deleted)
+	require.Empty(t, d.EndpointsDiff.Added)
+
+	// an operation whose endpoint didn't change is matched by path even if its operationId changed
+	require.Equal(t, "checkHealth", d.PathsDiff.Modified["/health"].OperationsDiff.Modified["GET"].OperationIDDiff.To)
+
+	require.Equal(t, 2, d.GetSummary().Details[diff.RenamedOperationsDetail].Modified)
+}
+
+func TestMatchOperationIds_Disabled(t *testing.T) {
+	d := getOperationIdsDiff(t, false)
+
+	require.Nil(t, d.RenamedOperationsDiff)
+	require.ElementsMatch(t, diff.Endpoints{
+		{Method: "GET", Path: "/v1/users/{id}"},
+		{Method: "DELETE", Path: "/v1/users/{id}"},
+	}, d.EndpointsDiff.Deleted)
+}
+
 func TestCircularSchema_Diff(t *testing.T) {
 	loader := openapi3.NewLoader()
 
@@ -821,3 +957,46 @@ func TestDiff_TwoPathParamsOneRenamed(t *testing.T) {
 	require.Equal(t, "libraryId", dd.From)
 	require.Equal(t, "otherId", dd.To)
 }
+
+func TestDiff_ParamsRenamed(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/param-move/base.yaml")
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile("../data/param-move/revision.yaml")
+	require.NoError(t, err)
+
+	d, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	paramsDiff := d.PathsDiff.Modified["/books"].OperationsDiff.Modified["GET"].ParametersDiff
+
+	// parameters without a matching heuristic remain deleted and added
+	require.Equal(t, diff.ParamNamesByLocation{"query": utils.StringList{"sort"}}, paramsDiff.Deleted)
+	require.Equal(t, diff.ParamNamesByLocation{"query": utils.StringList{"order"}}, paramsDiff.Added)
+
+	renamed := map[diff.ParamKey]*diff.RenamedParamDiff{}
+	for _, renamedParam := range paramsDiff.Renamed {
+		renamed[renamedParam.Base] = renamedParam
+	}
+	require.Len(t, renamed, 4)
+
+	// same name up to case and separators, and the same schema
+	require.Equal(t, diff.ParamKey{In: "query", Name: "pageSize"}, renamed[diff.ParamKey{In: "query", Name: "page_size"}].Revision)
+
+	// moved to another location
+	tenant := renamed[diff.ParamKey{In: "query", Name: "tenant"}]
+	require.Equal(t, diff.ParamKey{In: "header", Name: "Tenant"}, tenant.Revision)
+	require.True(t, tenant.Moved())
+
+	// x-renamed-from, with the schema diff between the pair
+	search := renamed[diff.ParamKey{In: "query", Name: "q"}]
+	require.Equal(t, diff.ParamKey{In: "query", Name: "search"}, search.Revision)
+	require.False(t, search.Moved())
+	require.Equal(t, &diff.ValueDiff{From: "q", To: "search"}, search.ParameterDiff.NameDiff)
+	require.NotNil(t, search.ParameterDiff.SchemaDiff.MaxLengthDiff)
+
+	// the same description and schema
+	require.Equal(t, diff.ParamKey{In: "query", Name: "after"}, renamed[diff.ParamKey{In: "query", Name: "cursor"}].Revision)
+}
@@ -86,7 +86,8 @@ func getEndpointsDiff(config *Config, state *state, paths1, paths2 openapi3.Path
 	}
 
 	if config.BreakingOnly {
-		diff.removeNonBreaking(paths1)
+		// the deleted endpoints are rewritten, so they are looked up in the rewritten base paths
+		diff.removeNonBreaking(rewritePrefix(paths1, config.baseRewriter()))
 	}
 
 	if diff.Empty() {
@@ -100,8 +101,8 @@ func getEndpointsDiffInternal(config *Config, state *state, paths1, paths2 opena
 
 	result := newEndpointsDiff()
 
-	paths1Mod := rewritePrefix(paths1, config.PathStripPrefixBase, config.PathPrefixBase)
-	paths2Mod := rewritePrefix(paths2, config.PathStripPrefixRevision, config.PathPrefixRevision)
+	paths1Mod := rewritePrefix(paths1, config.baseRewriter())
+	paths2Mod := rewritePrefix(paths2, config.revisionRewriter())
 
 	addedPaths, deletedPaths, otherPaths := getPathItemsDiff(config, paths1Mod, paths2Mod)
 
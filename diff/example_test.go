@@ -116,9 +116,15 @@ func ExampleGetPathsDiff() {
 	}
 
 	// Output:
-	// Backward compatibility errors (4):
+	// Backward compatibility errors (7):
 	// error at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score removed the success response with the status '201' [response-success-status-removed].
 	//
+	// error at ../data/openapi-test3.yaml, in API POST /register removed the security requirement 'bearerAuth' [api-security-removed].
+	//
+	// error at ../data/openapi-test3.yaml, in API POST /register added the required scope 'write:pets' to the security scheme 'OAuth' [api-security-scope-added].
+	//
+	// error at ../data/openapi-test3.yaml, in API POST /subscribe in the request of the callback 'myEvent' 'POST hi', which is sent to subscribers: the response's property type/format changed from 'number'/'none' to 'string'/'none' for status 'callback request' [callback-response-property-type-changed].
+	//
 	// warning at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score deleted the 'cookie' request parameter 'test' [request-parameter-removed].
 	//
 	// warning at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score deleted the 'header' request parameter 'user' [request-parameter-removed].
@@ -0,0 +1,311 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/load"
+)
+
+// LocationsMap maps the elements of the base and revision specs to their locations in the spec files, see GetLocationsMap
+type LocationsMap struct {
+	Base     ElementsLocations
+	Revision ElementsLocations
+}
+
+// ElementsLocations maps spec elements, like *openapi3.Operation, *openapi3.Parameter or *openapi3.Schema, to their locations
+// elements which aren't represented by objects, like the global security requirements, are mapped by their JSON pointers
+type ElementsLocations map[interface{}]load.Location
+
+// Find returns the location of the first element which has a location, or nil if none of them has
+func (locations ElementsLocations) Find(elements ...interface{}) *load.Location {
+	for _, element := range elements {
+		if location, ok := locations[element]; ok {
+			return &location
+		}
+	}
+	return nil
+}
+
+/*
+GetLocationsMap returns the locations of the elements of the base and revision specs
+elements which are shared by references, like component schemas, are located at their definition, also when it is in another file
+elements of specs without locations, like remote specs, are omitted
+*/
+func GetLocationsMap(base, revision []load.SpecInfo) *LocationsMap {
+	return &LocationsMap{
+		Base:     getElementsLocations(base),
+		Revision: getElementsLocations(revision),
+	}
+}
+
+func getElementsLocations(specs []load.SpecInfo) ElementsLocations {
+	result := ElementsLocations{}
+	for _, spec := range specs {
+		if spec.Spec == nil || spec.Locations == nil {
+			continue
+		}
+		walker := &locationsWalker{
+			files:   map[string]load.Locations{spec.Url: spec.Locations},
+			result:  result,
+			visited: map[*openapi3.Schema]bool{},
+		}
+		walker.walkSpec(spec.Spec, spec.Webhooks, elementPosition{file: spec.Url})
+	}
+	return result
+}
+
+// elementPosition is the position of an element in a spec file, see load.Locations
+type elementPosition struct {
+	file    string
+	pointer string
+}
+
+// child returns the position of a child element with the given reference tokens
+func (position elementPosition) child(tokens ...string) elementPosition {
+	return elementPosition{file: position.file, pointer: position.pointer + load.JSONPointer(tokens...)}
+}
+
+// locationsWalker walks a spec and maps its elements to their locations, the first location found for an element is kept
+type locationsWalker struct {
+	files   map[string]load.Locations // the locations of the spec files by their location, nil if they can't be determined
+	result  ElementsLocations
+	visited map[*openapi3.Schema]bool
+}
+
+func (walker *locationsWalker) add(element interface{}, position elementPosition) {
+	if _, ok := walker.result[element]; ok {
+		return
+	}
+	if location, ok := walker.getLocations(position.file)[position.pointer]; ok {
+		walker.result[element] = location
+	}
+}
+
+func (walker *locationsWalker) getLocations(file string) load.Locations {
+	if locations, ok := walker.files[file]; ok {
+		return locations
+	}
+	locations := load.GetLocationsFrom(file)
+	walker.files[file] = locations
+	return locations
+}
+
+// follow returns the position of the element referenced by ref, or the given position if ref is empty
+// references to other files, like 'common.yaml#/components/schemas/Pet', are resolved relative to the file which contains them
+func (walker *locationsWalker) follow(ref string, position elementPosition) elementPosition {
+	if ref == "" {
+		return position
+	}
+	file, pointer, _ := strings.Cut(ref, "#")
+	if file == "" {
+		return elementPosition{file: position.file, pointer: pointer}
+	}
+	return elementPosition{file: load.RelativeLocation(position.file, file), pointer: pointer}
+}
+
+func (walker *locationsWalker) walkSpec(spec *openapi3.T, webhooks openapi3.Paths, position elementPosition) {
+	// components are walked first so that referenced elements are located at their definition
+	walker.walkComponents(spec.Components, position.child("components"))
+
+	if len(spec.Security) > 0 {
+		walker.add(load.JSONPointer("security"), position.child("security"))
+	}
+
+	for path, pathItem := range spec.Paths {
+		walker.walkPathItem(pathItem, position.child("paths", path))
+	}
+
+	for name, pathItem := range webhooks {
+		walker.walkPathItem(pathItem, position.child("webhooks", name))
+	}
+}
+
+func (walker *locationsWalker) walkComponents(components *openapi3.Components, position elementPosition) {
+	if components == nil {
+		return
+	}
+	for name, schemaRef := range components.Schemas {
+		if schemaRef != nil {
+			walker.walkSchema(schemaRef.Value, walker.follow(schemaRef.Ref, position.child("schemas", name)))
+		}
+	}
+	for name, parameterRef := range components.Parameters {
+		if parameterRef != nil {
+			walker.walkParameter(parameterRef.Value, walker.follow(parameterRef.Ref, position.child("parameters", name)))
+		}
+	}
+	for name, headerRef := range components.Headers {
+		if headerRef != nil {
+			walker.walkHeader(headerRef.Value, walker.follow(headerRef.Ref, position.child("headers", name)))
+		}
+	}
+	for name, requestBodyRef := range components.RequestBodies {
+		if requestBodyRef != nil {
+			walker.walkRequestBody(requestBodyRef.Value, walker.follow(requestBodyRef.Ref, position.child("requestBodies", name)))
+		}
+	}
+	for name, responseRef := range components.Responses {
+		if responseRef != nil {
+			walker.walkResponse(responseRef.Value, walker.follow(responseRef.Ref, position.child("responses", name)))
+		}
+	}
+	for name, securitySchemeRef := range components.SecuritySchemes {
+		schemePosition := position.child("securitySchemes", name)
+		walker.add(load.JSONPointer("components", "securitySchemes", name), schemePosition)
+		if securitySchemeRef != nil && securitySchemeRef.Value != nil {
+			walker.add(securitySchemeRef.Value, walker.follow(securitySchemeRef.Ref, schemePosition))
+		}
+	}
+	for name, callbackRef := range components.Callbacks {
+		if callbackRef != nil {
+			walker.walkCallback(callbackRef.Value, walker.follow(callbackRef.Ref, position.child("callbacks", name)))
+		}
+	}
+}
+
+func (walker *locationsWalker) walkPathItem(pathItem *openapi3.PathItem, position elementPosition) {
+	if pathItem == nil {
+		return
+	}
+	position = walker.follow(pathItem.Ref, position)
+	walker.add(pathItem, position)
+
+	for i, parameterRef := range pathItem.Parameters {
+		if parameterRef != nil {
+			walker.walkParameter(parameterRef.Value, walker.follow(parameterRef.Ref, position.child("parameters", strconv.Itoa(i))))
+		}
+	}
+
+	for method, operation := range pathItem.Operations() {
+		walker.walkOperation(operation, position.child(strings.ToLower(method)))
+	}
+}
+
+func (walker *locationsWalker) walkOperation(operation *openapi3.Operation, position elementPosition) {
+	if operation == nil {
+		return
+	}
+	walker.add(operation, position)
+
+	for i, parameterRef := range operation.Parameters {
+		if parameterRef != nil {
+			walker.walkParameter(parameterRef.Value, walker.follow(parameterRef.Ref, position.child("parameters", strconv.Itoa(i))))
+		}
+	}
+
+	if operation.RequestBody != nil {
+		walker.walkRequestBody(operation.RequestBody.Value, walker.follow(operation.RequestBody.Ref, position.child("requestBody")))
+	}
+
+	for status, responseRef := range operation.Responses {
+		if responseRef != nil {
+			walker.walkResponse(responseRef.Value, walker.follow(responseRef.Ref, position.child("responses", status)))
+		}
+	}
+
+	for name, callbackRef := range operation.Callbacks {
+		if callbackRef != nil {
+			walker.walkCallback(callbackRef.Value, walker.follow(callbackRef.Ref, position.child("callbacks", name)))
+		}
+	}
+}
+
+func (walker *locationsWalker) walkCallback(callback *openapi3.Callback, position elementPosition) {
+	if callback == nil {
+		return
+	}
+	walker.add(callback, position)
+
+	for expression, pathItem := range *callback {
+		walker.walkPathItem(pathItem, position.child(expression))
+	}
+}
+
+func (walker *locationsWalker) walkParameter(parameter *openapi3.Parameter, position elementPosition) {
+	if parameter == nil {
+		return
+	}
+	walker.add(parameter, position)
+
+	if parameter.Schema != nil {
+		walker.walkSchema(parameter.Schema.Value, walker.follow(parameter.Schema.Ref, position.child("schema")))
+	}
+	walker.walkContent(parameter.Content, position)
+}
+
+func (walker *locationsWalker) walkRequestBody(requestBody *openapi3.RequestBody, position elementPosition) {
+	if requestBody == nil {
+		return
+	}
+	walker.add(requestBody, position)
+	walker.walkContent(requestBody.Content, position)
+}
+
+func (walker *locationsWalker) walkResponse(response *openapi3.Response, position elementPosition) {
+	if response == nil {
+		return
+	}
+	walker.add(response, position)
+
+	for name, headerRef := range response.Headers {
+		if headerRef != nil {
+			walker.walkHeader(headerRef.Value, walker.follow(headerRef.Ref, position.child("headers", name)))
+		}
+	}
+	walker.walkContent(response.Content, position)
+}
+
+func (walker *locationsWalker) walkHeader(header *openapi3.Header, position elementPosition) {
+	if header == nil {
+		return
+	}
+	walker.add(header, position)
+
+	if header.Schema != nil {
+		walker.walkSchema(header.Schema.Value, walker.follow(header.Schema.Ref, position.child("schema")))
+	}
+	walker.walkContent(header.Content, position)
+}
+
+func (walker *locationsWalker) walkContent(content openapi3.Content, position elementPosition) {
+	for mediaType, mediaTypeObject := range content {
+		if mediaTypeObject == nil {
+			continue
+		}
+		mediaTypePosition := position.child("content", mediaType)
+		walker.add(mediaTypeObject, mediaTypePosition)
+		if mediaTypeObject.Schema != nil {
+			walker.walkSchema(mediaTypeObject.Schema.Value, walker.follow(mediaTypeObject.Schema.Ref, mediaTypePosition.child("schema")))
+		}
+	}
+}
+
+func (walker *locationsWalker) walkSchema(schema *openapi3.Schema, position elementPosition) {
+	if schema == nil || walker.visited[schema] {
+		return
+	}
+	walker.visited[schema] = true
+	walker.add(schema, position)
+
+	walkSchemaRef := func(schemaRef *openapi3.SchemaRef, tokens ...string) {
+		if schemaRef != nil {
+			walker.walkSchema(schemaRef.Value, walker.follow(schemaRef.Ref, position.child(tokens...)))
+		}
+	}
+
+	for name, property := range schema.Properties {
+		walkSchemaRef(property, "properties", name)
+	}
+
+	walkSchemaRef(schema.Items, "items")
+	walkSchemaRef(schema.AdditionalProperties.Schema, "additionalProperties")
+	walkSchemaRef(schema.Not, "not")
+
+	for keyword, schemaRefs := range map[string]openapi3.SchemaRefs{"allOf": schema.AllOf, "anyOf": schema.AnyOf, "oneOf": schema.OneOf} {
+		for i, schemaRef := range schemaRefs {
+			walkSchemaRef(schemaRef, keyword, strconv.Itoa(i))
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func TestGetLocationsMap(t *testing.T) {
+	const file = "../data/openapi-test1.yaml"
+	spec, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), file)
+	require.NoError(t, err)
+
+	locations := diff.GetLocationsMap([]load.SpecInfo{*spec}, nil)
+	require.Empty(t, locations.Revision)
+
+	pathItem := spec.Spec.Paths[securityScorePath]
+	require.Equal(t, load.Location{File: file, Line: 21, Column: 3}, locations.Base[pathItem])
+	require.Equal(t, load.Location{File: file, Line: 33, Column: 5}, locations.Base[pathItem.Get])
+	require.Equal(t, load.Location{File: file, Line: 40, Column: 9}, locations.Base[pathItem.Get.Parameters.GetByInAndName("query", "filter")])
+	require.Equal(t, load.Location{File: file, Line: 116, Column: 9}, locations.Base[pathItem.Get.Responses.Get(200).Value])
+	require.Equal(t, load.Location{File: file, Line: 293, Column: 5}, locations.Base[load.JSONPointer("components", "securitySchemes", "bearerAuth")])
+}
+
+func TestGetLocationsMap_NoLocations(t *testing.T) {
+	spec := load.SpecInfo{Spec: l(t, 1)}
+	require.Empty(t, diff.GetLocationsMap([]load.SpecInfo{spec}, []load.SpecInfo{spec}).Base)
+}
+
+func TestGetLocationsMap_ExternalRefs(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	spec, err := load.LoadSpecInfoFromFile(loader, "../data/locations/external/spec1.yaml")
+	require.NoError(t, err)
+
+	locations := diff.GetLocationsMap([]load.SpecInfo{*spec}, nil)
+
+	// elements of other files are located at their definition
+	const common = "../data/locations/external/sub/common1.yaml"
+	operation := spec.Spec.Paths["/pets"].Get
+	require.Equal(t, load.Location{File: common, Line: 3, Column: 5}, locations.Base[operation.Parameters.GetByInAndName("query", "limit")])
+	pet := operation.Responses.Get(200).Value.Content["application/json"].Schema.Value
+	require.Equal(t, load.Location{File: common, Line: 10, Column: 5}, locations.Base[pet])
+	require.Equal(t, load.Location{File: common, Line: 19, Column: 5}, locations.Base[pet.Properties["owner"].Value])
+}
@@ -103,7 +103,7 @@ func getMethodDiffInternal(config *Config, state *state, operation1, operation2
 		return nil, err
 	}
 	result.DeprecatedDiff = getValueDiff(operation1.Deprecated, operation2.Deprecated)
-	result.SecurityDiff = getSecurityRequirementsDiff(config, state, operation1.Security, operation2.Security)
+	result.SecurityDiff = getSecurityRequirementsDiff(config, state, getOperationSecurity(operation1, operation2, state.securityBase), getOperationSecurity(operation2, operation1, state.securityRevision))
 	result.ServersDiff = getServersDiff(config, state, operation1.Servers, operation2.Servers)
 	result.ExternalDocsDiff = getExternalDocsDiff(config, state, operation1.ExternalDocs, operation2.ExternalDocs)
 	result.Base = operation1
@@ -112,6 +112,15 @@ func getMethodDiffInternal(config *Config, state *state, operation1, operation2
 	return result, nil
 }
 
+// getOperationSecurity returns the security requirements of an operation
+// when only the other operation overrides the global security requirements, the global ones are returned to compare the effective requirements
+func getOperationSecurity(operation, other *openapi3.Operation, global *openapi3.SecurityRequirements) *openapi3.SecurityRequirements {
+	if operation.Security == nil && other.Security != nil {
+		return global
+	}
+	return operation.Security
+}
+
 // Patch applies the patch to a method
 func (methodDiff *MethodDiff) Patch(operation *openapi3.Operation) error {
 
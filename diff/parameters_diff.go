@@ -12,6 +12,7 @@ type ParametersDiff struct {
 	Added    ParamNamesByLocation `json:"added,omitempty" yaml:"added,omitempty"`
 	Deleted  ParamNamesByLocation `json:"deleted,omitempty" yaml:"deleted,omitempty"`
 	Modified ParamDiffByLocation  `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Renamed  RenamedParams        `json:"renamed,omitempty" yaml:"renamed,omitempty"`
 }
 
 // Empty indicates whether a change was found in this element
@@ -22,7 +23,8 @@ func (parametersDiff *ParametersDiff) Empty() bool {
 
 	return len(parametersDiff.Added) == 0 &&
 		len(parametersDiff.Deleted) == 0 &&
-		len(parametersDiff.Modified) == 0
+		len(parametersDiff.Modified) == 0 &&
+		len(parametersDiff.Renamed) == 0
 }
 
 func (parametersDiff *ParametersDiff) removeNonBreaking(params2 openapi3.Parameters) {
@@ -163,6 +165,10 @@ func getParametersDiffInternal(config *Config, state *state, params1, params2 op
 		}
 	}
 
+	if err := result.matchRenamedParams(config, state, params1, params2); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
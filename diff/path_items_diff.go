@@ -42,14 +42,52 @@ func getPathItemsDiff(config *Config, paths1, paths2 openapi3.Paths) (openapi3.P
 	return added, deleted, other
 }
 
-func rewritePrefix(paths openapi3.Paths, strip, prepend string) openapi3.Paths {
+// pathRewriter rewrites the paths of one of the specs before they are matched
+// the first matching rewrite rule is applied before the prefix is stripped and prepended
+type pathRewriter struct {
+	spec    string
+	rules   RewriteRules
+	strip   string
+	prepend string
+}
+
+func (config *Config) baseRewriter() pathRewriter {
+	return pathRewriter{
+		spec:    RewriteSpecBase,
+		rules:   config.PathRewriteRules,
+		strip:   config.PathStripPrefixBase,
+		prepend: config.PathPrefixBase,
+	}
+}
+
+func (config *Config) revisionRewriter() pathRewriter {
+	return pathRewriter{
+		spec:    RewriteSpecRevision,
+		rules:   config.PathRewriteRules,
+		strip:   config.PathStripPrefixRevision,
+		prepend: config.PathPrefixRevision,
+	}
+}
+
+func rewritePrefix(paths openapi3.Paths, rewriter pathRewriter) openapi3.Paths {
 	result := make(openapi3.Paths, len(paths))
 	for path, pathItem := range paths {
-		result[prepend+strings.TrimPrefix(path, strip)] = pathItem
+		result[rewriter.rewritePath(path)] = pathItem
 	}
 	return result
 }
 
+func (rewriter pathRewriter) rewritePath(path string) string {
+	result, _ := rewriter.rewritePathWithRule(path)
+	return result
+}
+
+// rewritePathWithRule rewrites the path and returns the rewrite rule which matched it, or nil if none did
+func (rewriter pathRewriter) rewritePathWithRule(path string) (string, *RewriteRule) {
+	path, rule := rewriter.rules.rewrite(rewriter.spec, path)
+	return rewriter.prepend + strings.TrimPrefix(path, rewriter.strip), rule
+}
+
 func findEndpoint(config *Config, endpoint string, paths openapi3.Paths) (*openapi3.PathItem, PathParamsMap, bool) {
 	if pathItem, ok := paths[endpoint]; ok {
 		return pathItem, PathParamsMap{}, true
@@ -0,0 +1,96 @@
+package diff
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/utils"
+)
+
+// PathRewrites maps the paths in the diff which were rewritten by rewrite rules to their original paths, see Config.PathRewriteRules
+type PathRewrites map[string]*PathRewrite
+
+// PathRewrite describes how the base and/or revision paths were rewritten into a path in the diff
+type PathRewrite struct {
+	Base     *RewrittenPath `json:"base,omitempty" yaml:"base,omitempty"`
+	Revision *RewrittenPath `json:"revision,omitempty" yaml:"revision,omitempty"`
+}
+
+// RewrittenPath is the original path in a spec and the rule which rewrote it
+type RewrittenPath struct {
+	Path string `json:"path" yaml:"path"`
+	Rule string `json:"rule" yaml:"rule"`
+}
+
+// Empty indicates whether a change was found in this element
+func (pathRewrites *PathRewrites) Empty() bool {
+	return pathRewrites == nil || len(*pathRewrites) == 0
+}
+
+// getPathRewrites returns the rewrites of the paths which appear in the diff
+func getPathRewrites(config *Config, paths1, paths2 openapi3.Paths, diff *Diff) *PathRewrites {
+	if len(config.PathRewriteRules) == 0 {
+		return nil
+	}
+
+	diffPaths := diff.getPaths()
+	result := PathRewrites{}
+
+	add := func(paths openapi3.Paths, rewriter pathRewriter, set func(pathRewrite *PathRewrite, rewrittenPath *RewrittenPath)) {
+		for path := range paths {
+			rewritten, rule := rewriter.rewritePathWithRule(path)
+			if rule == nil || !diffPaths.Contains(rewritten) {
+				continue
+			}
+			if _, ok := result[rewritten]; !ok {
+				result[rewritten] = &PathRewrite{}
+			}
+			set(result[rewritten], &RewrittenPath{Path: path, Rule: rule.String()})
+		}
+	}
+
+	add(paths1, config.baseRewriter(), func(pathRewrite *PathRewrite, rewrittenPath *RewrittenPath) { pathRewrite.Base = rewrittenPath })
+	add(paths2, config.revisionRewriter(), func(pathRewrite *PathRewrite, rewrittenPath *RewrittenPath) { pathRewrite.Revision = rewrittenPath })
+
+	if result.Empty() {
+		return nil
+	}
+
+	return &result
+}
+
+// getPaths returns the paths which appear in the paths, endpoints and renamed operations of the diff
+func (diff *Diff) getPaths() utils.StringSet {
+	result := utils.StringSet{}
+
+	if diff.PathsDiff != nil {
+		for _, path := range diff.PathsDiff.Added {
+			result.Add(path)
+		}
+		for _, path := range diff.PathsDiff.Deleted {
+			result.Add(path)
+		}
+		for path := range diff.PathsDiff.Modified {
+			result.Add(path)
+		}
+	}
+
+	if diff.EndpointsDiff != nil {
+		for _, endpoint := range diff.EndpointsDiff.Added {
+			result.Add(endpoint.Path)
+		}
+		for _, endpoint := range diff.EndpointsDiff.Deleted {
+			result.Add(endpoint.Path)
+		}
+		for endpoint := range diff.EndpointsDiff.Modified {
+			result.Add(endpoint.Path)
+		}
+	}
+
+	if diff.RenamedOperationsDiff != nil {
+		for _, renamedOperation := range *diff.RenamedOperationsDiff {
+			result.Add(renamedOperation.Base.Path)
+			result.Add(renamedOperation.Revision.Path)
+		}
+	}
+
+	return result
+}
@@ -78,7 +78,8 @@ func getPathsDiff(config *Config, state *state, paths1, paths2 openapi3.Paths) (
 	}
 
 	if config.BreakingOnly {
-		diff.removeNonBreaking(paths1)
+		// the deleted paths are rewritten, so they are looked up in the rewritten base paths
+		diff.removeNonBreaking(diff.Base)
 	}
 
 	if diff.Empty() {
@@ -92,8 +93,8 @@ func getPathsDiffInternal(config *Config, state *state, paths1, paths2 openapi3.
 
 	result := newPathsDiff()
 
-	paths1Mod := rewritePrefix(paths1, config.PathStripPrefixBase, config.PathPrefixBase)
-	paths2Mod := rewritePrefix(paths2, config.PathStripPrefixRevision, config.PathPrefixRevision)
+	paths1Mod := rewritePrefix(paths1, config.baseRewriter())
+	paths2Mod := rewritePrefix(paths2, config.revisionRewriter())
 
 	addedPaths, deletedPaths, otherPaths := getPathItemsDiff(config, paths1Mod, paths2Mod)
 
@@ -0,0 +1,152 @@
+package diff
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/utils"
+)
+
+// RenamedOperationsDiff describes operations whose endpoint changed and which were matched by their operationId, keyed by the operationId
+// it is calculated only when Config.MatchOperationIds is set
+type RenamedOperationsDiff map[string]*RenamedOperationDiff
+
+// RenamedOperationDiff describes an operation whose endpoint changed, and the changes in the operation itself
+type RenamedOperationDiff struct {
+	Base       Endpoint    `json:"base" yaml:"base"`
+	Revision   Endpoint    `json:"revision" yaml:"revision"`
+	MethodDiff *MethodDiff `json:"diff,omitempty" yaml:"diff,omitempty"`
+
+	BaseOperation     *openapi3.Operation `json:"-" yaml:"-"`
+	RevisionOperation *openapi3.Operation `json:"-" yaml:"-"`
+}
+
+// Empty indicates whether a change was found in this element
+func (diff *RenamedOperationsDiff) Empty() bool {
+	return diff == nil || len(*diff) == 0
+}
+
+func (diff *RenamedOperationsDiff) getSummary() *SummaryDetails {
+	return &SummaryDetails{
+		Modified: len(*diff),
+	}
+}
+
+// operationEndpoint is an operation together with its endpoint, before and after rewriting the path prefix
+type operationEndpoint struct {
+	path      string // the path in the spec
+	endpoint  Endpoint
+	operation *openapi3.Operation
+}
+
+/*
+getRenamedOperationsDiff matches operations by operationId when their endpoint doesn't exist in the other spec
+the matched operations are removed from the returned paths, so that the path matching only compares the remaining operations
+an operation is matched only if its operationId is unique in both specs and neither of the endpoints can be matched by path
+*/
+func getRenamedOperationsDiff(config *Config, state *state, paths1, paths2 openapi3.Paths) (*RenamedOperationsDiff, openapi3.Paths, openapi3.Paths, error) {
+
+	if err := filterPaths(config.PathFilter, config.FilterExtension, paths1, paths2); err != nil {
+		return nil, nil, nil, err
+	}
+
+	paths1Mod := rewritePrefix(paths1, config.baseRewriter())
+	paths2Mod := rewritePrefix(paths2, config.revisionRewriter())
+
+	operations1 := getOperationsById(paths1, config.baseRewriter())
+	operations2 := getOperationsById(paths2, config.revisionRewriter())
+
+	result := RenamedOperationsDiff{}
+	matched1 := []operationEndpoint{}
+	matched2 := []operationEndpoint{}
+
+	for operationId, operation1 := range operations1 {
+		operation2, ok := operations2[operationId]
+		if !ok || operation1 == nil || operation2 == nil {
+			continue
+		}
+
+		if endpointExists(config, operation1.endpoint, paths2Mod) || endpointExists(config, operation2.endpoint, paths1Mod) {
+			continue
+		}
+
+		pathParamsMap := getRenamedPathParamsMap(operation1.endpoint.Path, operation2.endpoint.Path)
+		methodDiff, err := getMethodDiff(config, state, operation1.operation, operation2.operation, pathParamsMap)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		result[operationId] = &RenamedOperationDiff{
+			Base:       operation1.endpoint,
+			Revision:   operation2.endpoint,
+			MethodDiff: methodDiff,
+
+			BaseOperation:     operation1.operation,
+			RevisionOperation: operation2.operation,
+		}
+		matched1 = append(matched1, *operation1)
+		matched2 = append(matched2, *operation2)
+	}
+
+	if result.Empty() {
+		return nil, paths1, paths2, nil
+	}
+
+	return &result, removeOperations(paths1, matched1), removeOperations(paths2, matched2), nil
+}
+
+// getOperationsById maps operationIds to operations, duplicate operationIds are mapped to nil since they can't be matched
+func getOperationsById(paths openapi3.Paths, rewriter pathRewriter) map[string]*operationEndpoint {
+	result := map[string]*operationEndpoint{}
+	for path, pathItem := range paths {
+		for method, operation := range pathItem.Operations() {
+			if operation.OperationID == "" {
+				continue
+			}
+			if _, ok := result[operation.OperationID]; ok {
+				result[operation.OperationID] = nil
+				continue
+			}
+			result[operation.OperationID] = &operationEndpoint{
+				path:      path,
+				endpoint:  Endpoint{Method: method, Path: rewriter.rewritePath(path)},
+				operation: operation,
+			}
+		}
+	}
+	return result
+}
+
+// endpointExists indicates whether the endpoint can be matched by path in the given paths
+func endpointExists(config *Config, endpoint Endpoint, paths openapi3.Paths) bool {
+	pathItem, _, ok := findEndpoint(config, endpoint.Path, paths)
+	return ok && pathItem.GetOperation(endpoint.Method) != nil
+}
+
+// getRenamedPathParamsMap maps the path parameters of the renamed paths by position if both paths have the same number of parameters
+func getRenamedPathParamsMap(path1, path2 string) PathParamsMap {
+	_, _, pathParams1 := utils.NormalizeTemplatedPath(path1)
+	_, _, pathParams2 := utils.NormalizeTemplatedPath(path2)
+	if pathParamsMap, ok := NewPathParamsMap(pathParams1, pathParams2); ok {
+		return pathParamsMap
+	}
+	return PathParamsMap{}
+}
+
+// removeOperations returns a copy of the paths without the given operations, paths which are left without operations are removed
+func removeOperations(paths openapi3.Paths, operations []operationEndpoint) openapi3.Paths {
+	result := make(openapi3.Paths, len(paths))
+	for path, pathItem := range paths {
+		result[path] = pathItem
+	}
+
+	for _, operation := range operations {
+		pathItem := *result[operation.path]
+		pathItem.SetOperation(operation.endpoint.Method, nil)
+		if len(pathItem.Operations()) == 0 {
+			delete(result, operation.path)
+			continue
+		}
+		result[operation.path] = &pathItem
+	}
+
+	return result
+}
@@ -0,0 +1,191 @@
+package diff
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/utils"
+)
+
+// ParamKey identifies a parameter by its location and name
+type ParamKey struct {
+	In   string `json:"in" yaml:"in"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// RenamedParams lists the parameters which were moved to another location or renamed, see matchRenamedParams
+type RenamedParams []*RenamedParamDiff
+
+// RenamedParamDiff describes a parameter whose location or name changed, and the changes in the parameter itself
+type RenamedParamDiff struct {
+	Base          ParamKey       `json:"base" yaml:"base"`
+	Revision      ParamKey       `json:"revision" yaml:"revision"`
+	ParameterDiff *ParameterDiff `json:"diff,omitempty" yaml:"diff,omitempty"`
+}
+
+// Moved indicates whether the location of the parameter changed
+func (diff *RenamedParamDiff) Moved() bool {
+	return diff.Base.In != diff.Revision.In
+}
+
+// paramMatcher indicates whether a deleted and an added parameter are the same parameter
+type paramMatcher func(param1, param2 *openapi3.Parameter, paramDiff *ParameterDiff) bool
+
+/*
+paramMatchers are the heuristics which pair deleted and added parameters, by priority:
+1. the added parameter has an x-renamed-from extension with the name of the deleted parameter
+2. the names are equal up to case and separators, like page_size and pageSize, and the schemas are identical
+3. the descriptions are equal and not empty, and the schemas are identical
+*/
+var paramMatchers = []paramMatcher{
+	func(param1, param2 *openapi3.Parameter, paramDiff *ParameterDiff) bool {
+		return getRenamedFrom(param2) == param1.Name
+	},
+	func(param1, param2 *openapi3.Parameter, paramDiff *ParameterDiff) bool {
+		return normalizeParamName(param1.Name) == normalizeParamName(param2.Name) && sameParamSchema(paramDiff)
+	},
+	func(param1, param2 *openapi3.Parameter, paramDiff *ParameterDiff) bool {
+		return param1.Description != "" && param1.Description == param2.Description && sameParamSchema(paramDiff)
+	},
+}
+
+/*
+matchRenamedParams pairs deleted and added parameters which are most likely the same parameter, see paramMatchers
+the paired parameters are removed from the added and deleted parameters and are listed as renamed, with the diff between them
+a parameter is paired only if it has a single candidate, and path parameters aren't paired since they are bound to the path
+*/
+func (parametersDiff *ParametersDiff) matchRenamedParams(config *Config, state *state, params1, params2 openapi3.Parameters) error {
+	deleted := getParamsByKey(parametersDiff.Deleted, params1)
+	added := getParamsByKey(parametersDiff.Added, params2)
+	if len(deleted) == 0 || len(added) == 0 {
+		return nil
+	}
+
+	paramDiffs := map[ParamKey]map[ParamKey]*ParameterDiff{}
+	for key1, param1 := range deleted {
+		paramDiffs[key1] = map[ParamKey]*ParameterDiff{}
+		for key2, param2 := range added {
+			paramDiff, err := getParameterDiffInternal(config, state, param1, param2)
+			if err != nil {
+				return err
+			}
+			paramDiffs[key1][key2] = paramDiff
+		}
+	}
+
+	for _, matcher := range paramMatchers {
+		for _, key2 := range getSortedParamKeys(added) {
+			candidates := []ParamKey{}
+			for key1, param1 := range deleted {
+				if matcher(param1, added[key2], paramDiffs[key1][key2]) {
+					candidates = append(candidates, key1)
+				}
+			}
+			if len(candidates) != 1 || countParamMatches(matcher, deleted[candidates[0]], added, paramDiffs[candidates[0]]) != 1 {
+				continue
+			}
+
+			key1 := candidates[0]
+			paramDiff, err := getParameterDiff(config, state, deleted[key1], added[key2])
+			if err != nil {
+				return err
+			}
+			parametersDiff.Renamed = append(parametersDiff.Renamed, &RenamedParamDiff{
+				Base:          key1,
+				Revision:      key2,
+				ParameterDiff: paramDiff,
+			})
+			parametersDiff.Deleted.remove(key1)
+			parametersDiff.Added.remove(key2)
+			delete(deleted, key1)
+			delete(added, key2)
+		}
+	}
+
+	sort.Slice(parametersDiff.Renamed, func(i, j int) bool {
+		return parametersDiff.Renamed[i].Base.less(parametersDiff.Renamed[j].Base)
+	})
+	return nil
+}
+
+// countParamMatches returns the number of added parameters which match a deleted parameter
+func countParamMatches(matcher paramMatcher, param1 *openapi3.Parameter, added map[ParamKey]*openapi3.Parameter, paramDiffs map[ParamKey]*ParameterDiff) int {
+	result := 0
+	for key2, param2 := range added {
+		if matcher(param1, param2, paramDiffs[key2]) {
+			result++
+		}
+	}
+	return result
+}
+
+// getParamsByKey returns the parameters with the given names, except path parameters
+func getParamsByKey(paramNames ParamNamesByLocation, params openapi3.Parameters) map[ParamKey]*openapi3.Parameter {
+	result := map[ParamKey]*openapi3.Parameter{}
+	for location, names := range paramNames {
+		if location == openapi3.ParameterInPath {
+			continue
+		}
+		for _, name := range names {
+			if param := params.GetByInAndName(location, name); param != nil {
+				result[ParamKey{In: location, Name: name}] = param
+			}
+		}
+	}
+	return result
+}
+
+func getSortedParamKeys(params map[ParamKey]*openapi3.Parameter) []ParamKey {
+	result := make([]ParamKey, 0, len(params))
+	for key := range params {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].less(result[j])
+	})
+	return result
+}
+
+func (key ParamKey) less(other ParamKey) bool {
+	if key.In != other.In {
+		return key.In < other.In
+	}
+	return key.Name < other.Name
+}
+
+func (paramNames ParamNamesByLocation) remove(key ParamKey) {
+	names := utils.StringList{}
+	for _, name := range paramNames[key.In] {
+		if name != key.Name {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		delete(paramNames, key.In)
+		return
+	}
+	paramNames[key.In] = names
+}
+
+// getRenamedFrom returns the value of the x-renamed-from extension of a parameter
+func getRenamedFrom(param *openapi3.Parameter) string {
+	renamedFrom, ok := param.Extensions[XRenamedFromExtension].(string)
+	if !ok {
+		if renamedFromJson, ok := param.Extensions[XRenamedFromExtension].(json.RawMessage); ok {
+			_ = json.Unmarshal(renamedFromJson, &renamedFrom)
+		}
+	}
+	return renamedFrom
+}
+
+// normalizeParamName ignores case and separators, so that page_size, page-size and pageSize are equal
+func normalizeParamName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "", ".", "").Replace(name))
+}
+
+// sameParamSchema indicates whether a pair of parameters have identical schemas or contents
+func sameParamSchema(paramDiff *ParameterDiff) bool {
+	return paramDiff.SchemaDiff.Empty() && paramDiff.ContentDiff.Empty()
+}
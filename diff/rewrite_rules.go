@@ -0,0 +1,143 @@
+package diff
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	RewriteSpecBase     = "base"
+	RewriteSpecRevision = "revision"
+)
+
+/*
+RewriteRule rewrites the paths of the base and/or revision specs before they are matched
+A rule is either:
+- a literal mapping: a path which is equal to Path is replaced by Replacement
+- a regular expression: the matches of Regex are replaced by Replacement which may reference the capture groups as $1, $2, etc.
+*/
+type RewriteRule struct {
+	Name        string `json:"name,omitempty" yaml:"name,omitempty"` // optional name which is used to describe the rule in the diff
+	Spec        string `json:"spec,omitempty" yaml:"spec,omitempty"` // base, revision or empty for both
+	Path        string `json:"path,omitempty" yaml:"path,omitempty"`
+	Regex       string `json:"regex,omitempty" yaml:"regex,omitempty"`
+	Replacement string `json:"replacement" yaml:"replacement"`
+
+	regex *regexp.Regexp
+}
+
+// RewriteRules is an ordered list of rewrite rules, each path is rewritten by the first rule which matches it
+type RewriteRules []*RewriteRule
+
+// NewRegexRewriteRule returns a rule which replaces the matches of a regular expression in the paths of the given spec
+func NewRegexRewriteRule(spec, regex, replacement string) (*RewriteRule, error) {
+	rule := &RewriteRule{
+		Spec:        spec,
+		Regex:       regex,
+		Replacement: replacement,
+	}
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// LoadRewriteRules loads a rewrite rules file in YAML or JSON format
+func LoadRewriteRules(file string) (RewriteRules, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseRewriteRules(data)
+}
+
+// ParseRewriteRules parses and validates the contents of a rewrite rules file
+func ParseRewriteRules(data []byte) (RewriteRules, error) {
+	var rules RewriteRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	for i, rule := range rules {
+		if rule == nil {
+			return nil, fmt.Errorf("entry %d: empty rule", i+1)
+		}
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i+1, err)
+		}
+	}
+
+	return rules, nil
+}
+
+func (rule *RewriteRule) validate() error {
+	switch rule.Spec {
+	case "", RewriteSpecBase, RewriteSpecRevision:
+	default:
+		return fmt.Errorf("invalid spec %q, must be %q or %q", rule.Spec, RewriteSpecBase, RewriteSpecRevision)
+	}
+
+	if (rule.Path == "") == (rule.Regex == "") {
+		return fmt.Errorf("rule must have either a path or a regex")
+	}
+
+	if rule.Regex != "" {
+		regex, err := regexp.Compile(rule.Regex)
+		if err != nil {
+			return fmt.Errorf("failed to compile regex %q with %w", rule.Regex, err)
+		}
+		rule.regex = regex
+	}
+
+	return nil
+}
+
+// String returns a short description of the rule for the diff
+func (rule *RewriteRule) String() string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	if rule.Regex != "" {
+		return fmt.Sprintf("regex %s -> %s", rule.Regex, rule.Replacement)
+	}
+	return fmt.Sprintf("path %s -> %s", rule.Path, rule.Replacement)
+}
+
+func (rule *RewriteRule) appliesTo(spec string) bool {
+	return rule.Spec == "" || rule.Spec == spec
+}
+
+func (rule *RewriteRule) rewrite(path string) (string, bool) {
+	if rule.Regex == "" {
+		return rule.Replacement, path == rule.Path
+	}
+
+	if rule.regex == nil {
+		// rules which were created directly rather than loaded or created by NewRegexRewriteRule
+		if err := rule.validate(); err != nil {
+			return path, false
+		}
+	}
+
+	if !rule.regex.MatchString(path) {
+		return path, false
+	}
+	return rule.regex.ReplaceAllString(path, rule.Replacement), true
+}
+
+// rewrite rewrites a path of the given spec with the first matching rule and returns the rule, or nil if no rule matched
+func (rules RewriteRules) rewrite(spec, path string) (string, *RewriteRule) {
+	for _, rule := range rules {
+		if !rule.appliesTo(spec) {
+			continue
+		}
+		if result, ok := rule.rewrite(path); ok {
+			return result, rule
+		}
+	}
+	return path, nil
+}
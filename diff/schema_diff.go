@@ -9,49 +9,59 @@ import (
 
 // SchemaDiff describes the changes between a pair of schema objects: https://swagger.io/specification/#schema-object
 type SchemaDiff struct {
-	SchemaAdded                     bool                    `json:"schemaAdded,omitempty" yaml:"schemaAdded,omitempty"`
-	SchemaDeleted                   bool                    `json:"schemaDeleted,omitempty" yaml:"schemaDeleted,omitempty"`
-	CircularRefDiff                 bool                    `json:"circularRef,omitempty" yaml:"circularRef,omitempty"`
-	ExtensionsDiff                  *ExtensionsDiff         `json:"extensions,omitempty" yaml:"extensions,omitempty"`
-	OneOfDiff                       *SchemaListDiff         `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
-	AnyOfDiff                       *SchemaListDiff         `json:"anyOf,omitempty" yaml:"anyOf,omitempty"`
-	AllOfDiff                       *SchemaListDiff         `json:"allOf,omitempty" yaml:"allOf,omitempty"`
-	NotDiff                         *SchemaDiff             `json:"not,omitempty" yaml:"not,omitempty"`
-	TypeDiff                        *ValueDiff              `json:"type,omitempty" yaml:"type,omitempty"`
-	TitleDiff                       *ValueDiff              `json:"title,omitempty" yaml:"title,omitempty"`
-	FormatDiff                      *ValueDiff              `json:"format,omitempty" yaml:"format,omitempty"`
-	DescriptionDiff                 *ValueDiff              `json:"description,omitempty" yaml:"description,omitempty"`
-	EnumDiff                        *EnumDiff               `json:"enum,omitempty" yaml:"enum,omitempty"`
-	DefaultDiff                     *ValueDiff              `json:"default,omitempty" yaml:"default,omitempty"`
-	ExampleDiff                     *ValueDiff              `json:"example,omitempty" yaml:"example,omitempty"`
-	ExternalDocsDiff                *ExternalDocsDiff       `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
-	AdditionalPropertiesAllowedDiff *ValueDiff              `json:"additionalPropertiesAllowed,omitempty" yaml:"additionalPropertiesAllowed,omitempty"`
-	UniqueItemsDiff                 *ValueDiff              `json:"uniqueItems,omitempty" yaml:"uniqueItems,omitempty"`
-	ExclusiveMinDiff                *ValueDiff              `json:"exclusiveMin,omitempty" yaml:"exclusiveMin,omitempty"`
-	ExclusiveMaxDiff                *ValueDiff              `json:"exclusiveMax,omitempty" yaml:"exclusiveMax,omitempty"`
-	NullableDiff                    *ValueDiff              `json:"nullable,omitempty" yaml:"nullable,omitempty"`
-	ReadOnlyDiff                    *ValueDiff              `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
-	WriteOnlyDiff                   *ValueDiff              `json:"writeOnly,omitempty" yaml:"writeOnly,omitempty"`
-	AllowEmptyValueDiff             *ValueDiff              `json:"allowEmptyValue,omitempty" yaml:"allowEmptyValue,omitempty"`
-	XMLDiff                         *ValueDiff              `json:"XML,omitempty" yaml:"XML,omitempty"`
-	DeprecatedDiff                  *ValueDiff              `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
-	MinDiff                         *ValueDiff              `json:"min,omitempty" yaml:"min,omitempty"`
-	MaxDiff                         *ValueDiff              `json:"max,omitempty" yaml:"max,omitempty"`
-	MultipleOfDiff                  *ValueDiff              `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
-	MinLengthDiff                   *ValueDiff              `json:"minLength,omitempty" yaml:"minLength,omitempty"`
-	MaxLengthDiff                   *ValueDiff              `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
-	PatternDiff                     *ValueDiff              `json:"pattern,omitempty" yaml:"pattern,omitempty"`
-	MinItemsDiff                    *ValueDiff              `json:"minItems,omitempty" yaml:"minItems,omitempty"`
-	MaxItemsDiff                    *ValueDiff              `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
-	ItemsDiff                       *SchemaDiff             `json:"items,omitempty" yaml:"items,omitempty"`
-	RequiredDiff                    *RequiredPropertiesDiff `json:"required,omitempty" yaml:"required,omitempty"`
-	PropertiesDiff                  *SchemasDiff            `json:"properties,omitempty" yaml:"properties,omitempty"`
-	MinPropsDiff                    *ValueDiff              `json:"minProps,omitempty" yaml:"minProps,omitempty"`
-	MaxPropsDiff                    *ValueDiff              `json:"maxProps,omitempty" yaml:"maxProps,omitempty"`
-	AdditionalPropertiesDiff        *SchemaDiff             `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
-	DiscriminatorDiff               *DiscriminatorDiff      `json:"discriminatorDiff,omitempty" yaml:"discriminatorDiff,omitempty"`
-	Base                            *openapi3.SchemaRef     `json:"-" yaml:"-"`
-	Revision                        *openapi3.SchemaRef     `json:"-" yaml:"-"`
+	SchemaAdded                      bool                    `json:"schemaAdded,omitempty" yaml:"schemaAdded,omitempty"`
+	SchemaDeleted                    bool                    `json:"schemaDeleted,omitempty" yaml:"schemaDeleted,omitempty"`
+	CircularRefDiff                  bool                    `json:"circularRef,omitempty" yaml:"circularRef,omitempty"`
+	ExtensionsDiff                   *ExtensionsDiff         `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+	OneOfDiff                        *SchemaListDiff         `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
+	AnyOfDiff                        *SchemaListDiff         `json:"anyOf,omitempty" yaml:"anyOf,omitempty"`
+	AllOfDiff                        *SchemaListDiff         `json:"allOf,omitempty" yaml:"allOf,omitempty"`
+	NotDiff                          *SchemaDiff             `json:"not,omitempty" yaml:"not,omitempty"`
+	TypeDiff                         *ValueDiff              `json:"type,omitempty" yaml:"type,omitempty"`
+	TitleDiff                        *ValueDiff              `json:"title,omitempty" yaml:"title,omitempty"`
+	FormatDiff                       *ValueDiff              `json:"format,omitempty" yaml:"format,omitempty"`
+	DescriptionDiff                  *ValueDiff              `json:"description,omitempty" yaml:"description,omitempty"`
+	EnumDiff                         *EnumDiff               `json:"enum,omitempty" yaml:"enum,omitempty"`
+	DefaultDiff                      *ValueDiff              `json:"default,omitempty" yaml:"default,omitempty"`
+	ExampleDiff                      *ValueDiff              `json:"example,omitempty" yaml:"example,omitempty"`
+	ExternalDocsDiff                 *ExternalDocsDiff       `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	AdditionalPropertiesAllowedDiff  *ValueDiff              `json:"additionalPropertiesAllowed,omitempty" yaml:"additionalPropertiesAllowed,omitempty"`
+	UniqueItemsDiff                  *ValueDiff              `json:"uniqueItems,omitempty" yaml:"uniqueItems,omitempty"`
+	ExclusiveMinDiff                 *ValueDiff              `json:"exclusiveMin,omitempty" yaml:"exclusiveMin,omitempty"`
+	ExclusiveMaxDiff                 *ValueDiff              `json:"exclusiveMax,omitempty" yaml:"exclusiveMax,omitempty"`
+	NullableDiff                     *ValueDiff              `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	ReadOnlyDiff                     *ValueDiff              `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
+	WriteOnlyDiff                    *ValueDiff              `json:"writeOnly,omitempty" yaml:"writeOnly,omitempty"`
+	AllowEmptyValueDiff              *ValueDiff              `json:"allowEmptyValue,omitempty" yaml:"allowEmptyValue,omitempty"`
+	XMLDiff                          *ValueDiff              `json:"XML,omitempty" yaml:"XML,omitempty"`
+	DeprecatedDiff                   *ValueDiff              `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	MinDiff                          *ValueDiff              `json:"min,omitempty" yaml:"min,omitempty"`
+	MaxDiff                          *ValueDiff              `json:"max,omitempty" yaml:"max,omitempty"`
+	MultipleOfDiff                   *ValueDiff              `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
+	MinLengthDiff                    *ValueDiff              `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLengthDiff                    *ValueDiff              `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	PatternDiff                      *ValueDiff              `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MinItemsDiff                     *ValueDiff              `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	MaxItemsDiff                     *ValueDiff              `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	ItemsDiff                        *SchemaDiff             `json:"items,omitempty" yaml:"items,omitempty"`
+	RequiredDiff                     *RequiredPropertiesDiff `json:"required,omitempty" yaml:"required,omitempty"`
+	PropertiesDiff                   *SchemasDiff            `json:"properties,omitempty" yaml:"properties,omitempty"`
+	MinPropsDiff                     *ValueDiff              `json:"minProps,omitempty" yaml:"minProps,omitempty"`
+	MaxPropsDiff                     *ValueDiff              `json:"maxProps,omitempty" yaml:"maxProps,omitempty"`
+	AdditionalPropertiesDiff         *SchemaDiff             `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+	DiscriminatorDiff                *DiscriminatorDiff      `json:"discriminatorDiff,omitempty" yaml:"discriminatorDiff,omitempty"`
+	TypesDiff                        *StringsDiff            `json:"types,omitempty" yaml:"types,omitempty"`
+	ConstDiff                        *ValueDiff              `json:"const,omitempty" yaml:"const,omitempty"`
+	PrefixItemsDiff                  *SchemaListDiff         `json:"prefixItems,omitempty" yaml:"prefixItems,omitempty"`
+	DependentRequiredDiff            *DependentRequiredDiff  `json:"dependentRequired,omitempty" yaml:"dependentRequired,omitempty"`
+	IfDiff                           *SchemaDiff             `json:"if,omitempty" yaml:"if,omitempty"`
+	ThenDiff                         *SchemaDiff             `json:"then,omitempty" yaml:"then,omitempty"`
+	ElseDiff                         *SchemaDiff             `json:"else,omitempty" yaml:"else,omitempty"`
+	DefsDiff                         *SchemasDiff            `json:"defs,omitempty" yaml:"defs,omitempty"`
+	UnevaluatedPropertiesAllowedDiff *ValueDiff              `json:"unevaluatedPropertiesAllowed,omitempty" yaml:"unevaluatedPropertiesAllowed,omitempty"`
+	UnevaluatedPropertiesDiff        *SchemaDiff             `json:"unevaluatedProperties,omitempty" yaml:"unevaluatedProperties,omitempty"`
+	Base                             *openapi3.SchemaRef     `json:"-" yaml:"-"`
+	Revision                         *openapi3.SchemaRef     `json:"-" yaml:"-"`
 }
 
 // Empty indicates whether a change was found in this element
@@ -143,6 +153,10 @@ func (diff *SchemaDiff) removeNonBreaking(config *Config, state *state, schema1,
 	if !diff.MaxPropsDiff.maxBreakingUInt64(state.direction) { // *uint64
 		diff.MaxPropsDiff = nil
 	}
+
+	if !diff.UnevaluatedPropertiesAllowedDiff.CompareWithDefault(true, false, true) {
+		diff.UnevaluatedPropertiesAllowedDiff = nil
+	}
 }
 
 func getRequiredMap(direction direction, schema1, schema2 *openapi3.SchemaRef) map[string]bool {
@@ -407,6 +421,10 @@ func getSchemaDiffInternal(config *Config, state *state, schema1, schema2 *opena
 
 	result.DiscriminatorDiff = getDiscriminatorDiff(config, state, value1.Discriminator, value2.Discriminator)
 
+	if err := result.setKeywordsDiff(config, state, value1, value2); err != nil {
+		return nil, err
+	}
+
 	result.Base = schema1
 	result.Revision = schema2
 
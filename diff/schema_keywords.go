@@ -0,0 +1,177 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+)
+
+// schemaKeywords holds the JSON Schema 2020-12 keywords of a schema
+type schemaKeywords struct {
+	types                        utils.StringList
+	constValue                   interface{}
+	prefixItems                  openapi3.SchemaRefs
+	dependentRequired            map[string]utils.StringList
+	ifSchema                     *openapi3.SchemaRef
+	thenSchema                   *openapi3.SchemaRef
+	elseSchema                   *openapi3.SchemaRef
+	defs                         openapi3.Schemas
+	unevaluatedProperties        *openapi3.SchemaRef
+	unevaluatedPropertiesAllowed *bool
+}
+
+// getSchemaKeywords returns the JSON Schema 2020-12 keywords of a schema
+// the subschemas are parsed when the spec is loaded into a load.SpecInfo, the raw subschemas of specs which weren't loaded this way are ignored
+func getSchemaKeywords(schema *openapi3.Schema) (*schemaKeywords, error) {
+	result := schemaKeywords{
+		types: getSchemaTypes(schema),
+	}
+
+	if len(schema.Extensions) == 0 {
+		return &result, nil
+	}
+
+	result.constValue = schema.Extensions[load.KeywordConst]
+
+	var err error
+	if result.dependentRequired, err = getDependentRequired(schema.Extensions[load.KeywordDependentRequired]); err != nil {
+		return nil, err
+	}
+
+	result.prefixItems, _ = schema.Extensions[load.KeywordPrefixItems].(openapi3.SchemaRefs)
+	result.ifSchema, _ = schema.Extensions[load.KeywordIf].(*openapi3.SchemaRef)
+	result.thenSchema, _ = schema.Extensions[load.KeywordThen].(*openapi3.SchemaRef)
+	result.elseSchema, _ = schema.Extensions[load.KeywordElse].(*openapi3.SchemaRef)
+	result.defs, _ = schema.Extensions[load.KeywordDefs].(openapi3.Schemas)
+
+	// unevaluatedProperties is either a boolean or a schema
+	if allowed, ok := schema.Extensions[load.KeywordUnevaluatedProperties].(bool); ok {
+		result.unevaluatedPropertiesAllowed = &allowed
+	} else {
+		result.unevaluatedProperties, _ = schema.Extensions[load.KeywordUnevaluatedProperties].(*openapi3.SchemaRef)
+	}
+
+	return &result, nil
+}
+
+// getSchemaTypes returns the types of a schema, including a type array with several non-null types
+func getSchemaTypes(schema *openapi3.Schema) utils.StringList {
+	if types, ok := schema.Extensions[load.TypesExtension].([]interface{}); ok {
+		result := utils.StringList{}
+		for _, t := range types {
+			result = append(result, fmt.Sprintf("%v", t))
+		}
+		sort.Sort(result)
+		return result
+	}
+
+	if schema.Type == "" {
+		return nil
+	}
+	return utils.StringList{schema.Type}
+}
+
+func getDependentRequired(value interface{}) (map[string]utils.StringList, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	result := map[string]utils.StringList{}
+	if err := convertKeyword(load.KeywordDependentRequired, value, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// convertKeyword converts the raw value of a keyword, as loaded by kin-openapi, to a typed value
+func convertKeyword(keyword string, value interface{}, result interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", keyword, err)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("failed to read %q: %w", keyword, err)
+	}
+	return nil
+}
+
+// setKeywordsDiff sets the diffs of the JSON Schema 2020-12 keywords
+func (diff *SchemaDiff) setKeywordsDiff(config *Config, state *state, value1, value2 *openapi3.Schema) error {
+	keywords1, err := getSchemaKeywords(value1)
+	if err != nil {
+		return err
+	}
+
+	keywords2, err := getSchemaKeywords(value2)
+	if err != nil {
+		return err
+	}
+
+	// a single type is compared by TypeDiff, type arrays with several non-null types are compared here
+	if len(keywords1.types) > 1 || len(keywords2.types) > 1 {
+		diff.TypesDiff = getStringsDiff(keywords1.types, keywords2.types)
+	}
+
+	diff.ConstDiff = getValueDiff(keywords1.constValue, keywords2.constValue)
+
+	if diff.PrefixItemsDiff, err = getPrefixItemsDiff(config, state, keywords1.prefixItems, keywords2.prefixItems); err != nil {
+		return err
+	}
+
+	diff.DependentRequiredDiff = getDependentRequiredDiff(config, state, keywords1.dependentRequired, keywords2.dependentRequired)
+
+	if diff.IfDiff, err = getSchemaDiff(config, state, keywords1.ifSchema, keywords2.ifSchema); err != nil {
+		return err
+	}
+	if diff.ThenDiff, err = getSchemaDiff(config, state, keywords1.thenSchema, keywords2.thenSchema); err != nil {
+		return err
+	}
+	if diff.ElseDiff, err = getSchemaDiff(config, state, keywords1.elseSchema, keywords2.elseSchema); err != nil {
+		return err
+	}
+
+	if diff.DefsDiff, err = getSchemasDiff(config, state, keywords1.defs, keywords2.defs); err != nil {
+		return err
+	}
+
+	diff.UnevaluatedPropertiesAllowedDiff = getBoolRefDiff(keywords1.unevaluatedPropertiesAllowed, keywords2.unevaluatedPropertiesAllowed)
+	if diff.UnevaluatedPropertiesDiff, err = getSchemaDiff(config, state, keywords1.unevaluatedProperties, keywords2.unevaluatedProperties); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getPrefixItemsDiff compares prefixItems by position, since each item describes the element at the same position of the array
+// the modified items are keyed by their position, starting from 1, like the inline schemas of SchemaListDiff
+func getPrefixItemsDiff(config *Config, state *state, schemaRefs1, schemaRefs2 openapi3.SchemaRefs) (*SchemaListDiff, error) {
+	result := SchemaListDiff{
+		Modified: ModifiedSchemas{},
+	}
+
+	for i := 0; i < len(schemaRefs1) && i < len(schemaRefs2); i++ {
+		schemaDiff, err := getSchemaDiff(config, state, schemaRefs1[i], schemaRefs2[i])
+		if err != nil {
+			return nil, err
+		}
+		if !schemaDiff.Empty() {
+			result.Modified[fmt.Sprintf("#%d", i+1)] = schemaDiff
+		}
+	}
+
+	if len(schemaRefs2) > len(schemaRefs1) {
+		result.Added = len(schemaRefs2) - len(schemaRefs1)
+	} else {
+		result.Deleted = len(schemaRefs1) - len(schemaRefs2)
+	}
+
+	if result.Empty() {
+		return nil, nil
+	}
+
+	return &result, nil
+}
@@ -1,6 +1,7 @@
 package diff
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -9,9 +10,11 @@ import (
 
 // SecurityRequirementsDiff describes the changes between a pair of sets of security requirement objects: https://swagger.io/specification/#security-requirement-object
 type SecurityRequirementsDiff struct {
-	Added    utils.StringList             `json:"added,omitempty" yaml:"added,omitempty"`
-	Deleted  utils.StringList             `json:"deleted,omitempty" yaml:"deleted,omitempty"`
-	Modified ModifiedSecurityRequirements `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Added    utils.StringList               `json:"added,omitempty" yaml:"added,omitempty"`
+	Deleted  utils.StringList               `json:"deleted,omitempty" yaml:"deleted,omitempty"`
+	Modified ModifiedSecurityRequirements   `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Base     *openapi3.SecurityRequirements `json:"-" yaml:"-"`
+	Revision *openapi3.SecurityRequirements `json:"-" yaml:"-"`
 }
 
 // Empty indicates whether a change was found in this element
@@ -43,6 +46,9 @@ func getSecurityRequirementsDiff(config *Config, state *state, securityRequireme
 		return nil
 	}
 
+	diff.Base = securityRequirements1
+	diff.Revision = securityRequirements2
+
 	return diff
 }
 
@@ -54,10 +60,10 @@ func getSecurityRequirementsDiffInternal(config *Config, state *state, securityR
 		for _, securityRequirement1 := range *securityRequirements1 {
 			if securityRequirement2 := findSecurityRequirement(securityRequirement1, securityRequirements2); securityRequirement2 != nil {
 				if securityScopesDiff := getSecurityScopesDiff(securityRequirement1, securityRequirement2); !securityScopesDiff.Empty() {
-					result.Modified[getSecurityRequirementID(securityRequirement1)] = securityScopesDiff
+					result.Modified[GetSecurityRequirementID(securityRequirement1)] = securityScopesDiff
 				}
 			} else {
-				result.Deleted = append(result.Deleted, getSecurityRequirementID(securityRequirement1))
+				result.Deleted = append(result.Deleted, GetSecurityRequirementID(securityRequirement1))
 			}
 		}
 	}
@@ -65,7 +71,7 @@ func getSecurityRequirementsDiffInternal(config *Config, state *state, securityR
 	if securityRequirements2 != nil {
 		for _, securityRequirement2 := range *securityRequirements2 {
 			if securityRequirements1 := findSecurityRequirement(securityRequirement2, securityRequirements1); securityRequirements1 == nil {
-				result.Added = append(result.Added, getSecurityRequirementID(securityRequirement2))
+				result.Added = append(result.Added, GetSecurityRequirementID(securityRequirement2))
 			}
 		}
 	}
@@ -96,13 +102,15 @@ func getSecuritySchemes(securityRequirement openapi3.SecurityRequirement) utils.
 	return result
 }
 
-func getSecurityRequirementID(securityRequirement openapi3.SecurityRequirement) string {
+// GetSecurityRequirementID returns the id of a security requirement: the names of its security schemes, sorted and joined by AND
+func GetSecurityRequirementID(securityRequirement openapi3.SecurityRequirement) string {
 	results := make([]string, len(securityRequirement))
 	i := 0
 	for name := range securityRequirement {
 		results[i] = name
 		i++
 	}
+	sort.Strings(results)
 	return strings.Join(results, " AND ")
 }
 
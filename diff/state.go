@@ -1,6 +1,9 @@
 package diff
 
-import "github.com/tufin/oasdiff/utils"
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/utils"
+)
 
 type direction int
 
@@ -14,6 +17,8 @@ type state struct {
 	visitedSchemasRevision utils.VisitedRefs
 	cache                  directionalSchemaDiffCache
 	direction              direction
+	securityBase           *openapi3.SecurityRequirements // global security requirements, applied to operations that don't override them
+	securityRevision       *openapi3.SecurityRequirements
 }
 
 func newState() *state {
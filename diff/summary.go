@@ -32,6 +32,7 @@ type DetailName string
 const (
 	// Swagger
 	PathsDetail        DetailName = "paths"
+	WebhooksDetail     DetailName = "webhooks"
 	SecurityDetail     DetailName = "security"
 	ServersDetail      DetailName = "servers"
 	TagsDetail         DetailName = "tags"
@@ -49,7 +50,8 @@ const (
 	CallbacksDetail       DetailName = "callbacks"
 
 	// Special
-	EndpointsDetail DetailName = "endpoints"
+	EndpointsDetail         DetailName = "endpoints"
+	RenamedOperationsDetail DetailName = "renamedOperations"
 )
 
 // GetSummaryDetails returns the summary for a specific part
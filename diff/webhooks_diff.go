@@ -0,0 +1,42 @@
+package diff
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// getWebhooksDiff compares the webhooks by name, unlike paths they are not affected by path matching, prefixes and filters
+func getWebhooksDiff(config *Config, state *state, webhooks1, webhooks2 openapi3.Paths) (*PathsDiff, error) {
+
+	result := newPathsDiff()
+
+	for name, pathItem1 := range webhooks1 {
+		pathItem2, ok := webhooks2[name]
+		if !ok {
+			result.addDeletedPath(name)
+			continue
+		}
+
+		if err := result.addModifiedPath(config, state, name, &pathItemPair{PathItem1: pathItem1, PathItem2: pathItem2, PathParamsMap: PathParamsMap{}}); err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range webhooks2 {
+		if _, ok := webhooks1[name]; !ok {
+			result.addAddedPath(name)
+		}
+	}
+
+	result.Base = webhooks1
+	result.Revision = webhooks2
+
+	if config.BreakingOnly {
+		result.removeNonBreaking(webhooks1)
+	}
+
+	if result.Empty() {
+		return nil, nil
+	}
+
+	return result, nil
+}
@@ -3,17 +3,25 @@ package internal
 import (
 	"fmt"
 	"io"
+	"time"
 
+	"github.com/tufin/oasdiff/build"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/checker/localizations"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/report"
 )
 
-func handleBreakingChanges(stdout io.Writer, diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, inputFlags *InputFlags) (bool, *ReturnError) {
+func handleBreakingChanges(stdout io.Writer, stderr io.Writer, diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, locations *diff.LocationsMap, inputFlags *InputFlags) (bool, *ReturnError) {
 	var c checker.BackwardCompatibilityCheckConfig
 	var level checker.Level
 
 	c = checker.GetAllChecks(inputFlags.includeChecks)
+	for id, level := range inputFlags.severityOverrides {
+		c.LogLevelOverrides[id] = level
+	}
+	c.MinSunsetBetaDays = inputFlags.minSunsetBetaDays
+	c.MinSunsetStableDays = inputFlags.minSunsetStableDays
 	// establish up to what level to log the changes
 	level = checker.INFO
 	if inputFlags.checkBreaking {
@@ -21,12 +29,32 @@ func handleBreakingChanges(stdout io.Writer, diffReport *diff.Diff, operationsSo
 	}
 
 	c.Localizer = *localizations.New(inputFlags.lang, "en")
+	c.Locations = locations
 
-	errs, returnErr := getBreakingChanges(c, diffReport, operationsSources, inputFlags.warnIgnoreFile, inputFlags.errIgnoreFile, level)
+	// the changes of all levels are collected, so that the ignore rules which match changes below the level aren't reported as stale
+	errs, returnErr := getBreakingChanges(c, diffReport, operationsSources, inputFlags.warnIgnoreFile, inputFlags.errIgnoreFile, checker.INFO)
 	if returnErr != nil {
 		return false, returnErr
 	}
 
+	errs = checker.IgnoreBackwardCompatibilityErrors(checker.WARN, errs, inputFlags.warnIgnoreRules)
+	errs = checker.IgnoreBackwardCompatibilityErrors(checker.ERR, errs, inputFlags.errIgnoreRules)
+
+	if inputFlags.ignoreFile != "" {
+		if errs, returnErr = applyIgnoreFile(stderr, errs, inputFlags.ignoreFile); returnErr != nil {
+			return false, returnErr
+		}
+	}
+
+	errs = filterLevel(errs, level)
+
+	if inputFlags.template != "" {
+		if returnErr := printTemplate(stdout, inputFlags.template, inputFlags.format, report.NewChangesTemplateData(diffReport, errs, c.Localizer), c.Localizer); returnErr != nil {
+			return false, returnErr
+		}
+		return errs.IsEmpty(inputFlags.failOnWarns), nil
+	}
+
 	switch inputFlags.format {
 	case FormatYAML:
 		if err := printYAML(stdout, errs); err != nil {
@@ -36,6 +64,14 @@ func handleBreakingChanges(stdout io.Writer, diffReport *diff.Diff, operationsSo
 		if err := printJSON(stdout, errs); err != nil {
 			return false, getErrFailedPrint("breaking changes JSON", err)
 		}
+	case FormatSarif:
+		if err := printJSON(stdout, report.GetSarifReport(errs, checker.GetAllRules(), build.Version)); err != nil {
+			return false, getErrFailedPrint("breaking changes SARIF", err)
+		}
+	case FormatMarkdown, FormatHTML:
+		if returnErr := printChangelog(stdout, errs, diffReport, c.Localizer, inputFlags.format); returnErr != nil {
+			return false, returnErr
+		}
 	case FormatText:
 		if len(errs) > 0 {
 			fmt.Fprintf(stdout, c.Localizer.Get("messages.total-errors"), len(errs))
@@ -51,6 +87,25 @@ func handleBreakingChanges(stdout io.Writer, diffReport *diff.Diff, operationsSo
 	return errs.IsEmpty(inputFlags.failOnWarns), nil
 }
 
+// printChangelog prints the changes grouped by kind and by endpoint
+func printChangelog(stdout io.Writer, errs checker.BackwardCompatibilityErrors, diffReport *diff.Diff, l localizations.Localizer, format string) *ReturnError {
+	changelog := report.GetChangelog(errs, diffReport, l)
+
+	var output string
+	var err error
+	if format == FormatHTML {
+		output, err = report.GetChangelogHTML(changelog, l)
+	} else {
+		output, err = report.GetChangelogMarkdown(changelog, l)
+	}
+	if err != nil {
+		return getErrFailedPrint("changelog "+format, err)
+	}
+
+	fmt.Fprint(stdout, output)
+	return nil
+}
+
 func getBreakingChanges(c checker.BackwardCompatibilityCheckConfig, diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, warnIgnoreFile string, errIgnoreFile string, level checker.Level) (checker.BackwardCompatibilityErrors, *ReturnError) {
 
 	errs := checker.CheckBackwardCompatibilityUntilLevel(c, diffReport, operationsSources, level)
@@ -73,3 +128,35 @@ func getBreakingChanges(c checker.BackwardCompatibilityCheckConfig, diffReport *
 
 	return errs, nil
 }
+
+// filterLevel returns the changes up to the given level
+func filterLevel(errs checker.BackwardCompatibilityErrors, level checker.Level) checker.BackwardCompatibilityErrors {
+	result := make(checker.BackwardCompatibilityErrors, 0, len(errs))
+	for _, err := range errs {
+		if err.Level <= level {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// applyIgnoreFile removes the errors matched by a structured ignore file and reports the entries which can be cleaned up
+func applyIgnoreFile(stderr io.Writer, errs checker.BackwardCompatibilityErrors, ignoreFile string) (checker.BackwardCompatibilityErrors, *ReturnError) {
+	rules, err := checker.LoadIgnoreRules(ignoreFile)
+	if err != nil {
+		return nil, getErrCantProcessIgnoreFile("structured", err)
+	}
+
+	now := time.Now()
+	errs, stale := rules.Apply(errs, now)
+
+	for _, rule := range stale {
+		if rule.IsExpired(now) {
+			fmt.Fprintf(stderr, "ignore entry %q expired on %s\n", rule.String(), rule.Expires)
+			continue
+		}
+		fmt.Fprintf(stderr, "ignore entry %q doesn't match any change and can be removed\n", rule.String())
+	}
+
+	return errs, nil
+}
@@ -0,0 +1,192 @@
+package internal
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/tufin/oasdiff/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultConfigFile is loaded from the current directory when the "-config" flag isn't specified
+const defaultConfigFile = ".oasdiff.yaml"
+
+// config file keys which don't correspond to command-line flags
+const (
+	configKeyErrIgnoreRules  = "err-ignore-rules"
+	configKeyWarnIgnoreRules = "warn-ignore-rules"
+)
+
+// flags which can't be set in the config file
+var nonConfigFlags = utils.StringList{"help", "version", "config"}.ToStringSet()
+
+// flags which select the mode, they are applied first and in this order, so that the other keys can be skipped in the modes they don't apply to
+var modeConfigFlags = utils.StringList{"lint", "check-breaking", "changelog", "fail-on-diff"}
+
+// config keys which apply only to some modes, they are skipped in the other modes so that a single config file can serve all of them
+var (
+	lintConfigKeys     = utils.StringList{"spec", "lint-checks", "lint-exclude-checks"}.ToStringSet()
+	diffConfigKeys     = utils.StringList{"base", "revision", "check-breaking", "changelog", "summary", "template", "fail-on-diff", "fail-on-warns"}.ToStringSet()
+	breakingConfigKeys = utils.StringList{"include-checks", "ignore", "severity"}.ToStringSet()
+)
+
+// loadConfigFile applies the config file to the flags which weren't set explicitly on the command line
+func loadConfigFile(flags *flag.FlagSet, inputFlags *InputFlags) *ReturnError {
+	configFile := inputFlags.config
+	if configFile == "" {
+		if _, err := os.Stat(defaultConfigFile); errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		configFile = defaultConfigFile
+	}
+
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return getErrInvalidConfig(configFile, err)
+	}
+
+	if err := applyConfig(flags, inputFlags, data); err != nil {
+		return getErrInvalidConfig(configFile, err)
+	}
+
+	return nil
+}
+
+func applyConfig(flags *flag.FlagSet, inputFlags *InputFlags, data []byte) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return err
+	}
+
+	if len(root.Content) == 0 {
+		// empty config
+		return nil
+	}
+
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: config must be a mapping of keys to values", doc.Line)
+	}
+
+	explicitFlags := utils.StringSet{}
+	flags.Visit(func(f *flag.Flag) {
+		explicitFlags.Add(f.Name)
+	})
+
+	for _, modeFlag := range modeConfigFlags {
+		if err := applyConfigKeys(flags, inputFlags, explicitFlags, doc, func(key string) bool { return key == modeFlag }); err != nil {
+			return err
+		}
+	}
+
+	return applyConfigKeys(flags, inputFlags, explicitFlags, doc, func(key string) bool { return !modeConfigFlags.Contains(key) })
+}
+
+// applyConfigKeys applies the keys of the config which match the filter, in the order of the config file
+func applyConfigKeys(flags *flag.FlagSet, inputFlags *InputFlags, explicitFlags utils.StringSet, doc *yaml.Node, filter func(key string) bool) error {
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		if !filter(key.Value) {
+			continue
+		}
+		if err := applyConfigKey(flags, inputFlags, explicitFlags, key.Value, value); err != nil {
+			return fmt.Errorf("line %d: %v", key.Line, err)
+		}
+	}
+
+	return nil
+}
+
+// isConfigKeyRelevant returns false for the config keys which don't apply to the current mode
+func isConfigKeyRelevant(inputFlags *InputFlags, key string) bool {
+	if inputFlags.lint {
+		return !diffConfigKeys.Contains(key) && !breakingConfigKeys.Contains(key)
+	}
+
+	switch {
+	case lintConfigKeys.Contains(key):
+		return false
+	case breakingConfigKeys.Contains(key):
+		return inputFlags.checkBreaking || inputFlags.changelog
+	case key == "fail-on-warns":
+		return inputFlags.checkBreaking && inputFlags.failOnDiff
+	}
+
+	return true
+}
+
+func applyConfigKey(flags *flag.FlagSet, inputFlags *InputFlags, explicitFlags utils.StringSet, key string, value *yaml.Node) error {
+	switch key {
+	case configKeyErrIgnoreRules:
+		return value.Decode(&inputFlags.errIgnoreRules)
+	case configKeyWarnIgnoreRules:
+		return value.Decode(&inputFlags.warnIgnoreRules)
+	}
+
+	if flags.Lookup(key) == nil || nonConfigFlags.Contains(key) {
+		return fmt.Errorf("unknown key %q", key)
+	}
+
+	flagValues, err := getConfigFlagValues(flags.Lookup(key), value)
+	if err != nil {
+		return err
+	}
+
+	if key == "severity" {
+		// validate the check ids here so that they are reported with their line in the config file
+		severities := severityFlag{}
+		for _, flagValue := range flagValues {
+			_ = severities.Set(flagValue)
+		}
+		if _, err := parseSeverities(severities); err != nil {
+			return err
+		}
+	}
+
+	// explicit command-line flags override the config file
+	if explicitFlags.Contains(key) || !isConfigKeyRelevant(inputFlags, key) {
+		return nil
+	}
+
+	for _, flagValue := range flagValues {
+		if err := flags.Set(key, flagValue); err != nil {
+			return fmt.Errorf("invalid value %q for %q: %v", flagValue, key, err)
+		}
+	}
+
+	return nil
+}
+
+// repeatableFlag is implemented by the flags which can be repeated on the command line, each value adds to the previous ones
+type repeatableFlag interface {
+	repeatable()
+}
+
+/*
+getConfigFlagValues returns the values of a flag in the config file in the command-line syntax
+a list is passed as separate values to a repeatable flag, like a flag repeated on the command line, and as a comma-separated value to other flags
+*/
+func getConfigFlagValues(f *flag.Flag, value *yaml.Node) ([]string, error) {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		return []string{value.Value}, nil
+	case yaml.SequenceNode:
+		items := make([]string, len(value.Content))
+		for i, item := range value.Content {
+			if item.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("%q must be a list of values", f.Name)
+			}
+			items[i] = item.Value
+		}
+		if _, ok := f.Value.(repeatableFlag); ok {
+			return items, nil
+		}
+		return []string{strings.Join(items, ",")}, nil
+	default:
+		return nil, fmt.Errorf("%q must be a value or a list of values", f.Name)
+	}
+}
@@ -37,6 +37,13 @@ func getErrFailedToLoadSpec(what string, path string, err error) *ReturnError {
 	}
 }
 
+func getErrInvalidConfig(path string, err error) *ReturnError {
+	return &ReturnError{
+		Err:  fmt.Errorf("invalid config file %q: %v", path, err),
+		Code: 103,
+	}
+}
+
 func getErrDiffFailed(err error) *ReturnError {
 	return &ReturnError{
 		Err:  fmt.Errorf("diff failed with %v", err),
@@ -71,9 +78,24 @@ func getErrUnsupportedBreakingChangesFormat(format string) *ReturnError {
 		Code: 109,
 	}
 }
+
+func getErrUnsupportedLintFormat(format string) *ReturnError {
+	return &ReturnError{
+		Err:  fmt.Errorf("format %q is not supported with \"-lint\"", format),
+		Code: 110,
+	}
+}
+
 func getErrCantProcessIgnoreFile(what string, err error) *ReturnError {
 	return &ReturnError{
 		Err:  fmt.Errorf("can't process %s ignore file %v", what, err),
 		Code: 121,
 	}
 }
+
+func getErrFailedToLoadTemplate(path string, err error) *ReturnError {
+	return &ReturnError{
+		Err:  fmt.Errorf("failed to load template %q with %v", path, err),
+		Code: 122,
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/lint"
 	"github.com/tufin/oasdiff/utils"
 )
 
@@ -19,6 +20,10 @@ type InputFlags struct {
 	prefixRevision           string
 	stripPrefixBase          string
 	strip_prefix_revision    string
+	rewriteBase              rewriteFlag
+	rewriteRevision          rewriteFlag
+	rewriteRulesFile         string
+	rewriteRules             diff.RewriteRules
 	prefix                   string
 	filter                   string
 	filterExtension          string
@@ -30,8 +35,10 @@ type InputFlags struct {
 	changelog                bool
 	warnIgnoreFile           string
 	errIgnoreFile            string
+	ignoreFile               string
 	deprecationDays          int
 	format                   string
+	template                 string
 	lang                     string
 	failOnDiff               bool
 	failOnWarns              bool
@@ -39,8 +46,20 @@ type InputFlags struct {
 	circularReferenceCounter int
 	excludeEndpoints         bool
 	matchPathParams          bool
+	matchOperationIds        bool
 	includeChecks            utils.StringList
 	excludeElements          utils.StringList
+	lint                     bool
+	spec                     string
+	lintChecks               utils.StringList
+	lintExcludeChecks        utils.StringList
+	config                   string
+	minSunsetBetaDays        int
+	minSunsetStableDays      int
+	errIgnoreRules           []string
+	warnIgnoreRules          []string
+	severity                 severityFlag
+	severityOverrides        map[string]checker.Level
 }
 
 func parseFlags(args []string, stdout io.Writer) (*InputFlags, *ReturnError) {
@@ -53,13 +72,16 @@ func parseFlags(args []string, stdout io.Writer) (*InputFlags, *ReturnError) {
 
 	inputFlags := InputFlags{}
 	flags.BoolVar(&inputFlags.help, "help", false, "display help")
-	flags.StringVar(&inputFlags.base, "base", "", "path or URL (or a glob in Composed mode) of original OpenAPI spec in YAML or JSON format")
-	flags.StringVar(&inputFlags.revision, "revision", "", "path or URL (or a glob in Composed mode) of revised OpenAPI spec in YAML or JSON format")
+	flags.StringVar(&inputFlags.base, "base", "", "path, URL or git:<revision>:<path> (or a glob in Composed mode) of original OpenAPI spec in YAML or JSON format")
+	flags.StringVar(&inputFlags.revision, "revision", "", "path, URL or git:<revision>:<path> (or a glob in Composed mode) of revised OpenAPI spec in YAML or JSON format")
 	flags.BoolVar(&inputFlags.composed, "composed", false, "work in 'composed' mode, compare paths in all specs matching base and revision globs")
 	flags.StringVar(&inputFlags.prefixBase, "prefix-base", "", "if provided, paths in original (base) spec will be prefixed with the given prefix before comparison")
 	flags.StringVar(&inputFlags.prefixRevision, "prefix-revision", "", "if provided, paths in revised (revision) spec will be prefixed with the given prefix before comparison")
 	flags.StringVar(&inputFlags.stripPrefixBase, "strip-prefix-base", "", "if provided, this prefix will be stripped from paths in original (base) spec before comparison")
 	flags.StringVar(&inputFlags.strip_prefix_revision, "strip-prefix-revision", "", "if provided, this prefix will be stripped from paths in revised (revision) spec before comparison")
+	flags.Var(&inputFlags.rewriteBase, "rewrite-base", "rewrite paths in original (base) spec before comparison by replacing the matches of a regular expression, in the format regex=replacement where the replacement may reference capture groups as $1; can be repeated")
+	flags.Var(&inputFlags.rewriteRevision, "rewrite-revision", "rewrite paths in revised (revision) spec before comparison by replacing the matches of a regular expression, in the format regex=replacement where the replacement may reference capture groups as $1; can be repeated")
+	flags.StringVar(&inputFlags.rewriteRulesFile, "rewrite-rules", "", "a file in YAML or JSON format with rules for rewriting paths before comparison, applied after the rules in '-rewrite-base' and '-rewrite-revision'")
 	flags.StringVar(&inputFlags.prefix, "prefix", "", "deprecated. use '-prefix-revision' instead")
 	flags.StringVar(&inputFlags.filter, "filter", "", "if provided, diff will include only paths that match this regular expression")
 	flags.StringVar(&inputFlags.filterExtension, "filter-extension", "", "if provided, diff will exclude paths and operations with an OpenAPI Extension matching this regular expression")
@@ -71,8 +93,10 @@ func parseFlags(args []string, stdout io.Writer) (*InputFlags, *ReturnError) {
 	flags.BoolVar(&inputFlags.changelog, "changelog", false, "output changelog")
 	flags.StringVar(&inputFlags.warnIgnoreFile, "warn-ignore", "", "the configuration file for ignoring warnings with '-check-breaking'")
 	flags.StringVar(&inputFlags.errIgnoreFile, "err-ignore", "", "the configuration file for ignoring errors with '-check-breaking'")
+	flags.StringVar(&inputFlags.ignoreFile, "ignore", "", "a structured ignore file in YAML or JSON format which ignores breaking changes by check id, method, path and name, used together with '-check-breaking' or '-changelog'")
 	flags.IntVar(&inputFlags.deprecationDays, "deprecation-days", 0, "minimal number of days required between deprecating a resource and removing it without being considered 'breaking'")
-	flags.StringVar(&inputFlags.format, "format", "", "output format: yaml, json, text or html")
+	flags.StringVar(&inputFlags.format, "format", "", "output format: yaml, json, text, html, markdown (only with '-changelog') or sarif")
+	flags.StringVar(&inputFlags.template, "template", "", "a Go template file which replaces the output format, its output is rendered to HTML with '-format html', the template receives the diff, its summary and, with '-check-breaking' or '-changelog', the changes")
 	flags.StringVar(&inputFlags.lang, "lang", "en", "language for localized breaking changes checks errors")
 	flags.BoolVar(&inputFlags.failOnDiff, "fail-on-diff", false, "exit with return code 1 when any ERR-level breaking changes are found, used together with '-check-breaking'")
 	flags.BoolVar(&inputFlags.failOnWarns, "fail-on-warns", false, "exit with return code 1 when any WARN-level breaking changes are found, used together with '-check-breaking' and '-fail-on-diff'")
@@ -80,8 +104,17 @@ func parseFlags(args []string, stdout io.Writer) (*InputFlags, *ReturnError) {
 	flags.IntVar(&inputFlags.circularReferenceCounter, "max-circular-dep", 5, "maximum allowed number of circular dependencies between objects in OpenAPI specs")
 	flags.BoolVar(&inputFlags.excludeEndpoints, "exclude-endpoints", false, "exclude endpoints from output (deprecated, use '-exclude-elements endpoints' instead)")
 	flags.BoolVar(&inputFlags.matchPathParams, "match-path-params", false, "include path parameter names in endpoint matching")
+	flags.BoolVar(&inputFlags.matchOperationIds, "match-operation-ids", false, "match operations whose endpoint changed by their operationId, operations which can't be matched this way are matched by path")
 	flags.Var(&inputFlags.includeChecks, "include-checks", "comma-separated list of optional breaking-changes checks")
 	flags.Var(&inputFlags.excludeElements, "exclude-elements", "comma-separated list of elements to exclude from diff")
+	flags.BoolVar(&inputFlags.lint, "lint", false, "lint the OpenAPI spec given in '-spec' instead of comparing specs")
+	flags.StringVar(&inputFlags.spec, "spec", "", "path or URL of an OpenAPI spec in YAML or JSON format to lint, used together with '-lint'")
+	flags.Var(&inputFlags.lintChecks, "lint-checks", "comma-separated list of lint checks to run, used together with '-lint' (default: all checks)")
+	flags.Var(&inputFlags.lintExcludeChecks, "lint-exclude-checks", "comma-separated list of lint checks to skip, used together with '-lint'")
+	flags.StringVar(&inputFlags.config, "config", "", "path of a config file which sets flags and other settings (default: "+defaultConfigFile+" if it exists)")
+	flags.Var(&inputFlags.severity, "severity", "override the level of a breaking-changes check in the format check-id=ERR|WARN|INFO|NONE, NONE disables the check; can be repeated or comma-separated")
+	flags.IntVar(&inputFlags.minSunsetBetaDays, "min-sunset-beta-days", 31, "minimal number of days between deprecating a beta endpoint and its sunset date")
+	flags.IntVar(&inputFlags.minSunsetStableDays, "min-sunset-stable-days", 180, "minimal number of days between deprecating a stable endpoint and its sunset date")
 
 	flags.SetOutput(stdout)
 	if err := flags.Parse(args[1:]); err != nil {
@@ -93,6 +126,14 @@ func parseFlags(args []string, stdout io.Writer) (*InputFlags, *ReturnError) {
 		return nil, getErrNone()
 	}
 
+	if inputFlags.version {
+		return &inputFlags, nil
+	}
+
+	if returnErr := loadConfigFile(flags, &inputFlags); returnErr != nil {
+		return nil, returnErr
+	}
+
 	return &inputFlags, nil
 }
 
@@ -103,11 +144,16 @@ func isExcludeEndpoints(inputFlags *InputFlags) bool {
 func validateFormatFlag(inputFlags *InputFlags) *ReturnError {
 	var supportedFormats utils.StringSet
 
-	if inputFlags.checkBreaking || inputFlags.changelog {
+	if inputFlags.changelog {
 		if inputFlags.format == "" {
 			inputFlags.format = "text"
 		}
-		supportedFormats = utils.StringList{"yaml", "json", "text"}.ToStringSet()
+		supportedFormats = utils.StringList{"yaml", "json", "text", "markdown", "html", "sarif"}.ToStringSet()
+	} else if inputFlags.checkBreaking {
+		if inputFlags.format == "" {
+			inputFlags.format = "text"
+		}
+		supportedFormats = utils.StringList{"yaml", "json", "text", "sarif"}.ToStringSet()
 	} else {
 		if inputFlags.format == "" {
 			inputFlags.format = "yaml"
@@ -125,6 +171,12 @@ func validateFormatFlag(inputFlags *InputFlags) *ReturnError {
 }
 
 func validateFlags(inputFlags *InputFlags) *ReturnError {
+	if inputFlags.lint {
+		return validateLintFlags(inputFlags)
+	}
+	if inputFlags.spec != "" || len(inputFlags.lintChecks) > 0 || len(inputFlags.lintExcludeChecks) > 0 {
+		return getErrInvalidFlags(fmt.Errorf("\"-spec\", \"-lint-checks\" and \"-lint-exclude-checks\" are relevant only with \"-lint\""))
+	}
 	if inputFlags.base == "" {
 		return getErrInvalidFlags(fmt.Errorf("please specify the \"-base\" flag=the path of the original OpenAPI spec in YAML or JSON format"))
 	}
@@ -140,6 +192,12 @@ func validateFlags(inputFlags *InputFlags) *ReturnError {
 		}
 		inputFlags.prefixRevision = inputFlags.prefix
 	}
+	rewriteRules, err := getRewriteRules(inputFlags)
+	if err != nil {
+		return getErrInvalidFlags(fmt.Errorf("invalid rewrite rules: %v", err))
+	}
+	inputFlags.rewriteRules = rewriteRules
+
 	if inputFlags.failOnWarns {
 		if !inputFlags.checkBreaking || !inputFlags.failOnDiff {
 			return getErrInvalidFlags(fmt.Errorf("\"-fail-on-warns\" is relevant only with \"-check-breaking\" and \"-fail-on-diff\""))
@@ -154,6 +212,20 @@ func validateFlags(inputFlags *InputFlags) *ReturnError {
 		return getErrInvalidFlags(fmt.Errorf("\"include-checks\" is relevant only with \"-check-breaking\" or \"-changelog"))
 	}
 
+	if inputFlags.ignoreFile != "" && !(inputFlags.checkBreaking || inputFlags.changelog) {
+		return getErrInvalidFlags(fmt.Errorf("\"-ignore\" is relevant only with \"-check-breaking\" or \"-changelog\""))
+	}
+
+	if len(inputFlags.severity) > 0 && !(inputFlags.checkBreaking || inputFlags.changelog) {
+		return getErrInvalidFlags(fmt.Errorf("\"-severity\" is relevant only with \"-check-breaking\" or \"-changelog\""))
+	}
+
+	severityOverrides, err := parseSeverities(inputFlags.severity)
+	if err != nil {
+		return getErrInvalidFlags(fmt.Errorf("invalid severity: %v", err))
+	}
+	inputFlags.severityOverrides = severityOverrides
+
 	if invalidChecks := checker.ValidateIncludeChecks(inputFlags.includeChecks); len(invalidChecks) > 0 {
 		return getErrInvalidFlags(fmt.Errorf("invalid include-checks=%s", inputFlags.includeChecks))
 	}
@@ -162,6 +234,46 @@ func validateFlags(inputFlags *InputFlags) *ReturnError {
 		return getErrInvalidFlags(fmt.Errorf("invalid exclude-elements=%s", inputFlags.excludeElements))
 	}
 
+	if inputFlags.minSunsetBetaDays < 0 || inputFlags.minSunsetStableDays < 0 {
+		return getErrInvalidFlags(fmt.Errorf("\"-min-sunset-beta-days\" and \"-min-sunset-stable-days\" can't be negative"))
+	}
+
+	return nil
+}
+
+func validateLintFlags(inputFlags *InputFlags) *ReturnError {
+	if inputFlags.spec == "" {
+		return getErrInvalidFlags(fmt.Errorf("please specify the \"-spec\" flag=the path of the OpenAPI spec to lint in YAML or JSON format"))
+	}
+	if inputFlags.base != "" || inputFlags.revision != "" {
+		return getErrInvalidFlags(fmt.Errorf("\"-lint\" can't be used with \"-base\" or \"-revision\""))
+	}
+	if inputFlags.checkBreaking || inputFlags.changelog || inputFlags.summary {
+		return getErrInvalidFlags(fmt.Errorf("\"-lint\" can't be used with \"-check-breaking\", \"-changelog\" or \"-summary\""))
+	}
+	if inputFlags.template != "" {
+		return getErrInvalidFlags(fmt.Errorf("\"-lint\" can't be used with \"-template\""))
+	}
+	if returnErr := validateLintFormatFlag(inputFlags); returnErr != nil {
+		return returnErr
+	}
+	if invalidChecks := lint.ValidateChecks(inputFlags.lintChecks); len(invalidChecks) > 0 {
+		return getErrInvalidFlags(fmt.Errorf("invalid lint-checks=%s", inputFlags.lintChecks))
+	}
+	if invalidChecks := lint.ValidateChecks(inputFlags.lintExcludeChecks); len(invalidChecks) > 0 {
+		return getErrInvalidFlags(fmt.Errorf("invalid lint-exclude-checks=%s", inputFlags.lintExcludeChecks))
+	}
+	return nil
+}
+
+func validateLintFormatFlag(inputFlags *InputFlags) *ReturnError {
+	if inputFlags.format == "" {
+		inputFlags.format = "text"
+	}
+	supportedFormats := utils.StringList{"yaml", "json", "text"}.ToStringSet()
+	if !supportedFormats.Contains(inputFlags.format) {
+		return getErrUnsupportedLintFormat(inputFlags.format)
+	}
 	return nil
 }
 
@@ -173,9 +285,11 @@ func generateConfig(inputFlags *InputFlags) *diff.Config {
 	config.PathPrefixRevision = inputFlags.prefixRevision
 	config.PathStripPrefixBase = inputFlags.stripPrefixBase
 	config.PathStripPrefixRevision = inputFlags.strip_prefix_revision
+	config.PathRewriteRules = inputFlags.rewriteRules
 	config.BreakingOnly = inputFlags.breakingOnly
 	config.DeprecationDays = inputFlags.deprecationDays
 	config.MatchPathParams = inputFlags.matchPathParams
+	config.MatchOperationIds = inputFlags.matchOperationIds
 	config.SetExcludeElements(inputFlags.excludeElements.ToStringSet(), inputFlags.excludeExamples, inputFlags.excludeDescription, inputFlags.excludeEndpoints)
 
 	if inputFlags.checkBreaking || inputFlags.changelog {
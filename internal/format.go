@@ -1,8 +1,10 @@
 package internal
 
 const (
-	FormatYAML = "yaml"
-	FormatJSON = "json"
-	FormatText = "text"
-	FormatHTML = "html"
+	FormatYAML     = "yaml"
+	FormatJSON     = "json"
+	FormatText     = "text"
+	FormatHTML     = "html"
+	FormatSarif    = "sarif"
+	FormatMarkdown = "markdown"
 )
@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/load"
+)
+
+func handleLint(stdout io.Writer, loader load.Loader, inputFlags *InputFlags) (bool, *ReturnError) {
+	spec, err := load.LoadSpecInfo(loader, inputFlags.spec)
+	if err != nil {
+		return false, getErrFailedToLoadSpec("lint", inputFlags.spec, err)
+	}
+
+	config := lint.GetConfig(inputFlags.lintChecks, inputFlags.lintExcludeChecks)
+	errs := lint.Run(*config, inputFlags.spec, spec)
+
+	switch inputFlags.format {
+	case FormatYAML:
+		if err := printYAML(stdout, errs); err != nil {
+			return false, getErrFailedPrint("lint YAML", err)
+		}
+	case FormatJSON:
+		if err := printJSON(stdout, errs); err != nil {
+			return false, getErrFailedPrint("lint JSON", err)
+		}
+	case FormatText:
+		if len(errs) > 0 {
+			fmt.Fprintf(stdout, "Lint errors (%d):\n", len(errs))
+		}
+
+		for _, lintErr := range errs {
+			fmt.Fprintf(stdout, "%s\n\n", lintErr)
+		}
+	default:
+		return false, getErrUnsupportedLintFormat(inputFlags.format)
+	}
+
+	return errs.HasErrors(), nil
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tufin/oasdiff/diff"
+)
+
+// rewriteFlag is a list of path rewrite rules in the format regex=replacement
+// the flag can be repeated, unlike other list flags it isn't comma-separated since commas may appear in regular expressions
+// a rule is split at the first '=', so the regex can't contain '=', such rules can be given in a rewrite rules file
+type rewriteFlag []string
+
+func (rewrite *rewriteFlag) repeatable() {}
+
+func (rewrite *rewriteFlag) String() string {
+	return strings.Join(*rewrite, " ")
+}
+
+func (rewrite *rewriteFlag) Set(value string) error {
+	*rewrite = append(*rewrite, value)
+	return nil
+}
+
+// getRewriteRules returns the rewrite rules of the flags followed by the rules of the rewrite rules file
+func getRewriteRules(inputFlags *InputFlags) (diff.RewriteRules, error) {
+	result := diff.RewriteRules{}
+
+	for _, flag := range []struct {
+		spec  string
+		rules rewriteFlag
+	}{
+		{diff.RewriteSpecBase, inputFlags.rewriteBase},
+		{diff.RewriteSpecRevision, inputFlags.rewriteRevision},
+	} {
+		for _, value := range flag.rules {
+			regex, replacement, found := strings.Cut(value, "=")
+			if !found {
+				return nil, fmt.Errorf("invalid rewrite rule %q, must be in the format regex=replacement", value)
+			}
+			rule, err := diff.NewRegexRewriteRule(flag.spec, regex, replacement)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, rule)
+		}
+	}
+
+	if inputFlags.rewriteRulesFile != "" {
+		rules, err := diff.LoadRewriteRules(inputFlags.rewriteRulesFile)
+		if err != nil {
+			return nil, fmt.Errorf("rewrite rules file %q: %v", inputFlags.rewriteRulesFile, err)
+		}
+		result = append(result, rules...)
+	}
+
+	return result, nil
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/build"
+	"github.com/tufin/oasdiff/checker/localizations"
 	"github.com/tufin/oasdiff/diff"
 	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/report"
 )
 
 func Run(args []string, stdout io.Writer, stderr io.Writer) int {
@@ -47,31 +49,44 @@ func runInternal(args []string, stdout io.Writer, stderr io.Writer) (bool, *Retu
 
 	openapi3.CircularReferenceCounter = inputFlags.circularReferenceCounter
 
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = load.ReadFromURI
+
+	if inputFlags.lint {
+		return handleLint(stdout, loader, inputFlags)
+	}
+
 	config := generateConfig(inputFlags)
 
 	var diffReport *diff.Diff
 	var operationsSources *diff.OperationsSourcesMap
-
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
+	var locations *diff.LocationsMap
 
 	if inputFlags.composed {
 		var err *ReturnError
-		if diffReport, operationsSources, err = composedDiff(loader, inputFlags.base, inputFlags.revision, config); err != nil {
+		if diffReport, operationsSources, locations, err = composedDiff(loader, inputFlags.base, inputFlags.revision, config); err != nil {
 			return false, err
 		}
 	} else {
 		var err *ReturnError
-		if diffReport, operationsSources, err = normalDiff(loader, inputFlags.base, inputFlags.revision, config); err != nil {
+		if diffReport, operationsSources, locations, err = normalDiff(loader, inputFlags.base, inputFlags.revision, config); err != nil {
 			return false, err
 		}
 	}
 
 	if inputFlags.checkBreaking || inputFlags.changelog {
-		diffEmpty, returnError := handleBreakingChanges(stdout, diffReport, operationsSources, inputFlags)
+		diffEmpty, returnError := handleBreakingChanges(stdout, stderr, diffReport, operationsSources, locations, inputFlags)
 		return failEmpty(inputFlags.failOnDiff, diffEmpty), returnError
 	}
 
+	if inputFlags.template != "" {
+		if returnErr := printTemplate(stdout, inputFlags.template, inputFlags.format, report.NewTemplateData(diffReport), *localizations.New(inputFlags.lang, "en")); returnErr != nil {
+			return false, returnErr
+		}
+		return failEmpty(inputFlags.failOnDiff, diffReport.Empty()), nil
+	}
+
 	if inputFlags.summary {
 		if err := printYAML(stdout, diffReport.GetSummary()); err != nil {
 			return false, getErrFailedPrint("summary", err)
@@ -82,40 +97,40 @@ func runInternal(args []string, stdout io.Writer, stderr io.Writer) (bool, *Retu
 	return failEmpty(inputFlags.failOnDiff, diffReport.Empty()), handleDiff(stdout, diffReport, inputFlags.format)
 }
 
-func normalDiff(loader load.Loader, base, revision string, config *diff.Config) (*diff.Diff, *diff.OperationsSourcesMap, *ReturnError) {
+func normalDiff(loader load.Loader, base, revision string, config *diff.Config) (*diff.Diff, *diff.OperationsSourcesMap, *diff.LocationsMap, *ReturnError) {
 	s1, err := load.LoadSpecInfo(loader, base)
 	if err != nil {
-		return nil, nil, getErrFailedToLoadSpec("base", base, err)
+		return nil, nil, nil, getErrFailedToLoadSpec("base", base, err)
 	}
 	s2, err := load.LoadSpecInfo(loader, revision)
 	if err != nil {
-		return nil, nil, getErrFailedToLoadSpec("revision", revision, err)
+		return nil, nil, nil, getErrFailedToLoadSpec("revision", revision, err)
 	}
 
 	diffReport, operationsSources, err := diff.GetWithOperationsSourcesMap(config, s1, s2)
 	if err != nil {
-		return nil, nil, getErrDiffFailed(err)
+		return nil, nil, nil, getErrDiffFailed(err)
 	}
 
-	return diffReport, operationsSources, nil
+	return diffReport, operationsSources, diff.GetLocationsMap([]load.SpecInfo{*s1}, []load.SpecInfo{*s2}), nil
 }
 
-func composedDiff(loader load.Loader, base, revision string, config *diff.Config) (*diff.Diff, *diff.OperationsSourcesMap, *ReturnError) {
+func composedDiff(loader load.Loader, base, revision string, config *diff.Config) (*diff.Diff, *diff.OperationsSourcesMap, *diff.LocationsMap, *ReturnError) {
 	s1, err := load.FromGlob(loader, base)
 	if err != nil {
-		return nil, nil, getErrFailedToLoadSpec("base", base, err)
+		return nil, nil, nil, getErrFailedToLoadSpec("base", base, err)
 	}
 
 	s2, err := load.FromGlob(loader, revision)
 	if err != nil {
-		return nil, nil, getErrFailedToLoadSpec("revision", revision, err)
+		return nil, nil, nil, getErrFailedToLoadSpec("revision", revision, err)
 	}
 	diffReport, operationsSources, err := diff.GetPathsDiff(config, s1, s2)
 	if err != nil {
-		return nil, nil, getErrDiffFailed(err)
+		return nil, nil, nil, getErrDiffFailed(err)
 	}
 
-	return diffReport, operationsSources, nil
+	return diffReport, operationsSources, diff.GetLocationsMap(s1, s2), nil
 }
 
 func failEmpty(failOnDiff, diffEmpty bool) bool {
@@ -9,7 +9,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
 	"github.com/tufin/oasdiff/internal"
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/report"
 	"gopkg.in/yaml.v3"
 )
 
@@ -139,7 +142,7 @@ func Test_BreakingChangesIgnoreErrs(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -err-ignore ../data/ignore-err-example.txt -format json"), &stdout, io.Discard))
 	bc := checker.BackwardCompatibilityErrors{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 5)
+	require.Len(t, bc, 12)
 }
 
 func Test_BreakingChangesIgnoreErrsAndWarns(t *testing.T) {
@@ -147,7 +150,7 @@ func Test_BreakingChangesIgnoreErrsAndWarns(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -err-ignore ../data/ignore-err-example.txt -warn-ignore ../data/ignore-warn-example.txt -format json"), &stdout, io.Discard))
 	bc := checker.BackwardCompatibilityErrors{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 4)
+	require.Len(t, bc, 11)
 }
 
 func Test_BreakingChangesInvalidIgnoreFile(t *testing.T) {
@@ -210,3 +213,314 @@ func Test_BreakingChangesChangelogOptionalCheckersAreInfoLevel(t *testing.T) {
 		require.Equal(t, c.Level, checker.INFO)
 	}
 }
+
+func Test_Lint(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/openapi.yaml"), &stdout, io.Discard))
+	require.Empty(t, stdout.String())
+}
+
+func Test_LintErrors(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/info/no-info.yaml -format json"), &stdout, io.Discard))
+	errs := lint.Errors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, "info-missing", errs[0].Id)
+}
+
+func Test_LintText(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/info/no-info.yaml"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "[info-missing]")
+}
+
+func Test_LintExcludeChecks(t *testing.T) {
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/info/no-info.yaml -lint-exclude-checks info"), io.Discard, io.Discard))
+}
+
+func Test_LintWarningsOnly(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/path-params/path-missing.yaml -lint-checks path-params -format yaml"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "path-param-missing")
+}
+
+func Test_LintInvalidCheck(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/openapi.yaml -lint-checks xxx"), io.Discard, io.Discard))
+}
+
+func Test_LintNoSpec(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -lint"), io.Discard, io.Discard))
+}
+
+func Test_LintInvalidFormat(t *testing.T) {
+	require.Equal(t, 110, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/openapi.yaml -format html"), io.Discard, io.Discard))
+}
+
+func Test_BreakingChangesSarif(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -format sarif"), &stdout, io.Discard))
+	sarif := report.SarifLog{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &sarif))
+	require.Len(t, sarif.Runs, 1)
+	require.NotEmpty(t, sarif.Runs[0].Results)
+}
+
+func Test_BreakingChangesLocations(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -format json"), &stdout, io.Discard))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+	for _, c := range bc {
+		if c.Path == "" {
+			continue
+		}
+		require.Equal(t, "../data/openapi-test1.yaml", c.BaseSource.File)
+		require.Equal(t, "../data/openapi-test3.yaml", c.RevisionSource.File)
+	}
+}
+
+func Test_Config(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -config ../data/config/oasdiff.yaml"), &stdout, io.Discard))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+	for _, c := range bc {
+		// overridden to INFO, which isn't reported with -check-breaking
+		require.NotEqual(t, "request-parameter-removed", c.Id)
+		// ignored by a rule in the config file
+		require.NotEqual(t, "removed the success response with the status '201'", c.UncolorizedText())
+	}
+}
+
+func Test_ConfigFlagsOverride(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -config ../data/config/oasdiff.yaml -format yaml"), &stdout, io.Discard))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+	require.Error(t, json.Unmarshal(stdout.Bytes(), &bc))
+}
+
+func Test_ConfigLint(t *testing.T) {
+	// the keys of the config file which don't apply to lint, like base and check-breaking, are skipped
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/lint/openapi.yaml -config ../data/config/breaking.yaml"), io.Discard, io.Discard))
+}
+
+func Test_ConfigDiff(t *testing.T) {
+	// the keys of the config file which apply only to check-breaking, like severity, are skipped, while fail-on-diff applies to the diff too
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff -config ../data/config/breaking.yaml -check-breaking=false"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "paths:")
+}
+
+func Test_ConfigMissing(t *testing.T) {
+	require.Equal(t, 103, internal.Run(cmdToArgs("oasdiff -config ../data/config/no-file.yaml"), io.Discard, io.Discard))
+}
+
+func Test_ConfigUnknownKey(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 103, internal.Run(cmdToArgs("oasdiff -config ../data/config/unknown-key.yaml"), io.Discard, &stderr))
+	require.Equal(t, "invalid config file \"../data/config/unknown-key.yaml\": line 2: unknown key \"fail-on-errors\"\n", stderr.String())
+}
+
+func Test_ConfigUnknownCheck(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 103, internal.Run(cmdToArgs("oasdiff -config ../data/config/unknown-check.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "line 1: unknown check id \"no-such-check\"")
+}
+
+func Test_ConfigInvalidValue(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 103, internal.Run(cmdToArgs("oasdiff -config ../data/config/invalid-value.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "line 1: invalid value \"maybe\" for \"check-breaking\"")
+}
+
+func Test_Severity(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -format json -severity request-parameter-removed=ERR,response-success-status-removed=NONE -severity api-security-removed=WARN"), &stdout, io.Discard))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+	for _, c := range bc {
+		require.NotEqual(t, "response-success-status-removed", c.Id)
+		switch c.Id {
+		case "request-parameter-removed":
+			require.Equal(t, checker.ERR, c.Level)
+		case "api-security-removed":
+			require.Equal(t, checker.WARN, c.Level)
+		}
+	}
+}
+
+func Test_SeverityOverridesConfig(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -config ../data/config/oasdiff.yaml -severity request-parameter-removed=WARN"), &stdout, io.Discard))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	count := 0
+	for _, c := range bc {
+		if c.Id == "request-parameter-removed" {
+			count++
+		}
+	}
+	require.NotZero(t, count)
+}
+
+func Test_SeverityUnknownCheck(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -severity no-such-check=ERR"), io.Discard, &stderr))
+	require.Equal(t, "invalid severity: unknown check id \"no-such-check\"\n", stderr.String())
+}
+
+func Test_SeverityInvalidLevel(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -severity api-tag-removed=FATAL"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "invalid level \"FATAL\"")
+}
+
+func Test_SeverityInvalidFormat(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -severity api-tag-removed"), io.Discard, io.Discard))
+}
+
+func Test_SeverityWithoutCheckBreaking(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -severity api-tag-removed=ERR"), io.Discard, io.Discard))
+}
+
+func Test_IgnoreFile(t *testing.T) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -format json -ignore ../data/ignore-rules.yaml"), &stdout, &stderr))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 9)
+	require.Equal(t, "ignore entry \"request-parameter-removed GET /api/{domain}/{project}/install-command\" expired on 2020-01-01\n"+
+		"ignore entry \"request-parameter-removed GET /api/{domain}/{project}/no-such-path\" doesn't match any change and can be removed\n", stderr.String())
+}
+
+func Test_IgnoreFileInfo(t *testing.T) {
+	// the rule matches an INFO change, which isn't reported with -check-breaking, so it isn't stale
+	var stderr bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -ignore ../data/ignore-rules-info.yaml"), io.Discard, &stderr))
+	require.Empty(t, stderr.String())
+}
+
+func Test_IgnoreFileInvalid(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 121, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -check-breaking -ignore ../data/ignore-rules-unknown-check.yaml"), io.Discard, &stderr))
+	require.Equal(t, "can't process structured ignore file entry 1: unknown check id \"no-such-check\"\n", stderr.String())
+}
+
+func Test_IgnoreFileWithoutCheckBreaking(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -base ../data/openapi-test1.yaml -revision ../data/openapi-test3.yaml -ignore ../data/ignore-rules.yaml"), io.Discard, io.Discard))
+}
+
+func Test_MatchOperationIds(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/operation-ids/base.yaml -revision ../data/operation-ids/revision.yaml -check-breaking -match-operation-ids -format json"), &stdout, io.Discard))
+	bc := checker.BackwardCompatibilityErrors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 3)
+}
+
+func Test_RewriteRulesFile(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/rewrite/base.yaml -revision ../data/rewrite/revision.yaml -rewrite-rules ../data/rewrite/rules.yaml -format json -exclude-elements endpoints"), &stdout, io.Discard))
+	var dd diff.Diff
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &dd))
+	require.Empty(t, dd.PathsDiff.Deleted)
+	require.Len(t, *dd.PathRewrites, 2)
+}
+
+func Test_RewriteFlags(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs(`oasdiff -base ../data/rewrite/base.yaml -revision ../data/rewrite/revision.yaml -rewrite-base ^/api/v(\d+)/=/ -rewrite-revision ^/owners/by-pet/(.*)$=/pets/$1/owner -format json -exclude-elements endpoints`), &stdout, io.Discard))
+	var dd diff.Diff
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &dd))
+	require.Empty(t, dd.PathsDiff.Deleted)
+	require.Empty(t, dd.PathsDiff.Added)
+	require.Equal(t, "regex ^/owners/by-pet/(.*)$ -> /pets/$1/owner", (*dd.PathRewrites)["/pets/{id}/owner"].Revision.Rule)
+}
+
+func Test_RewriteConfig(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -config ../data/config/rewrite.yaml"), &stdout, io.Discard))
+	var dd diff.Diff
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &dd))
+	require.Empty(t, dd.PathsDiff.Deleted)
+	require.Empty(t, dd.PathsDiff.Added)
+	require.Equal(t, `regex ^/pets/(\{id\})/owner$ -> /owners/by-pet/$1`, (*dd.PathRewrites)["/owners/by-pet/{id}"].Base.Rule)
+	require.Equal(t, `regex ^/api/v(\d+)/ -> /`, (*dd.PathRewrites)["/pets/{id}"].Base.Rule)
+}
+
+func Test_RewriteFlagInvalid(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -base ../data/rewrite/base.yaml -revision ../data/rewrite/revision.yaml -rewrite-base /api"), io.Discard, &stderr))
+	require.Equal(t, "invalid rewrite rules: invalid rewrite rule \"/api\", must be in the format regex=replacement\n", stderr.String())
+}
+
+func Test_ChangelogMarkdown(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -changelog -format markdown"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "## Breaking Changes\n\n### GET /pets/{id}\n")
+	require.Contains(t, stdout.String(), "_deprecated, sunset date 2030-01-01_")
+}
+
+func Test_ChangelogHTML(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -changelog -format html"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "<h2 id=\"breaking-changes\">Breaking Changes</h2>")
+}
+
+func Test_ChangelogTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -changelog -format markdown -template ../data/changelog/custom.tmpl"), &stdout, io.Discard))
+	require.Equal(t, "Breaking Changes\n* GET /pets/{id}\nPotential Breaking Changes\n* GET /pets/{id}\nDeprecations\n* GET /stores (sunset 2030-01-01)\nAdditions\n* POST /pets\n", stdout.String())
+}
+
+func Test_ChangelogTemplateHTML(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -changelog -format html -template ../data/changelog/custom.tmpl"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "<li>GET /stores (sunset 2030-01-01)")
+}
+
+func Test_ChangelogTemplateNotFound(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 122, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -changelog -format markdown -template ../data/changelog/no-such-file.tmpl"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "failed to load template \"../data/changelog/no-such-file.tmpl\"")
+}
+
+func Test_BreakingChangesTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -check-breaking -template ../data/template/changes-by-tag.tmpl"), &stdout, io.Discard))
+	require.Equal(t, "pets:\n  error GET /pets/{id}: added the new required 'query' request parameter 'fields'\n  warning GET /pets/{id}: added the pattern '^[0-9]+$' for the 'path' request parameter 'id'\n", stdout.String())
+}
+
+func Test_BreakingChangesTemplateFailOnDiff(t *testing.T) {
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -check-breaking -fail-on-diff -template ../data/template/changes-by-tag.tmpl"), io.Discard, io.Discard))
+}
+
+func Test_SummaryTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -summary -template ../data/template/summary.tmpl"), &stdout, io.Discard))
+	require.Equal(t, "endpoints: 1 added, 0 deleted, 2 modified\npaths: 0 added, 0 deleted, 3 modified\n", stdout.String())
+}
+
+func Test_DiffTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/base.yaml -template ../data/template/summary.tmpl"), &stdout, io.Discard))
+	require.Equal(t, "no changes\n", stdout.String())
+}
+
+func Test_TemplateExecuteFailed(t *testing.T) {
+	var stderr bytes.Buffer
+	// the changelog is available only with -check-breaking or -changelog
+	require.Equal(t, 105, internal.Run(cmdToArgs("oasdiff -base ../data/changelog/base.yaml -revision ../data/changelog/revision.yaml -template ../data/changelog/custom.tmpl"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "failed to execute custom template")
+}
+
+func Test_TemplateLint(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff -lint -spec ../data/changelog/base.yaml -template ../data/changelog/custom.tmpl"), io.Discard, io.Discard))
+}
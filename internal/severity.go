@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tufin/oasdiff/checker"
+)
+
+// severityFlag is a list of check severities in the format check-id=LEVEL
+// the flag can be repeated and accepts comma-separated lists
+type severityFlag []string
+
+func (severity *severityFlag) String() string {
+	return strings.Join(*severity, ",")
+}
+
+func (severity *severityFlag) repeatable() {}
+
+func (severity *severityFlag) Set(value string) error {
+	*severity = append(*severity, strings.Split(value, ",")...)
+	return nil
+}
+
+// parseSeverities returns the levels of the check ids in the severity flag
+func parseSeverities(severity severityFlag) (map[string]checker.Level, error) {
+	result := map[string]checker.Level{}
+	for _, item := range severity {
+		id, levelName, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found {
+			return nil, fmt.Errorf("invalid severity %q, must be in the format check-id=ERR|WARN|INFO|NONE", item)
+		}
+		level, err := getSeverity(id, levelName)
+		if err != nil {
+			return nil, err
+		}
+		result[id] = level
+	}
+	return result, nil
+}
+
+// getSeverity validates the check id and parses its level
+func getSeverity(id, levelName string) (checker.Level, error) {
+	if _, ok := checker.GetRule(id); !ok {
+		return checker.INFO, fmt.Errorf("unknown check id %q", id)
+	}
+	level, err := checker.ParseLevel(levelName)
+	if err != nil {
+		return checker.INFO, fmt.Errorf("%v for check id %q", err, id)
+	}
+	return level, nil
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tufin/oasdiff/checker/localizations"
+	"github.com/tufin/oasdiff/report"
+)
+
+// printTemplate prints the output of a custom template which replaces the output format
+// with the html format, the output of the template is rendered from markdown to HTML, other formats are ignored
+func printTemplate(stdout io.Writer, templateFile string, format string, data *report.TemplateData, l localizations.Localizer) *ReturnError {
+	templateText, err := os.ReadFile(templateFile)
+	if err != nil {
+		return getErrFailedToLoadTemplate(templateFile, err)
+	}
+
+	var output string
+	if format == FormatHTML {
+		output, err = report.GetTemplateHTML(string(templateText), data, l)
+	} else {
+		output, err = report.GetTemplateReport(string(templateText), data, l)
+	}
+	if err != nil {
+		return getErrFailedPrint("template", err)
+	}
+
+	fmt.Fprint(stdout, output)
+	return nil
+}
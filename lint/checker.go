@@ -1,6 +1,7 @@
 package lint
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/tufin/oasdiff/load"
@@ -48,6 +49,35 @@ func (e Errors) Swap(i, j int) {
 	e[i], e[j] = e[j], e[i]
 }
 
+// HasErrors indicates whether any of the errors is of level LEVEL_ERROR
+func (e Errors) HasErrors() bool {
+	for _, err := range e {
+		if err.Level == LEVEL_ERROR {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Error) levelName() string {
+	switch e.Level {
+	case LEVEL_ERROR:
+		return "error"
+	case LEVEL_WARN:
+		return "warning"
+	default:
+		return "issue"
+	}
+}
+
+func (e *Error) String() string {
+	result := fmt.Sprintf("%s\t[%s] at %s\n\t%s", e.levelName(), e.Id, e.Source, e.Text)
+	if e.Comment != "" {
+		result += fmt.Sprintf("\n\t%s", e.Comment)
+	}
+	return result
+}
+
 func Run(config Config, source string, spec *load.SpecInfo) Errors {
 	result := make(Errors, 0)
 
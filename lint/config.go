@@ -1,5 +1,9 @@
 package lint
 
+import (
+	"github.com/tufin/oasdiff/utils"
+)
+
 type Config struct {
 	Checks []Check
 }
@@ -14,6 +18,55 @@ func DefaultConfig() *Config {
 	}
 }
 
+// GetConfig returns a config with the checks in includeChecks, or all checks if includeChecks is empty, minus the checks in excludeChecks
+func GetConfig(includeChecks, excludeChecks utils.StringList) *Config {
+	ids := includeChecks
+	if len(ids) == 0 {
+		ids = GetCheckIds()
+	}
+
+	excluded := excludeChecks.ToStringSet()
+	checks := []Check{}
+	for _, id := range ids {
+		if excluded.Contains(id) {
+			continue
+		}
+		if check, ok := checksById[id]; ok {
+			checks = append(checks, check)
+		}
+	}
+
+	return NewConfig(checks)
+}
+
+var checksById = map[string]Check{
+	"schema":          SchemaCheck,
+	"path-params":     PathParamsCheck,
+	"required-params": RequiredParamsCheck,
+	"info":            InfoCheck,
+}
+
+// GetCheckIds returns the ids of all the lint checks
+func GetCheckIds() utils.StringList {
+	result := utils.StringList{}
+	for id := range checksById {
+		result = append(result, id)
+	}
+	return result.Sort()
+}
+
+// ValidateChecks returns the check ids which don't correspond to any lint check
+func ValidateChecks(checks utils.StringList) utils.StringList {
+	result := utils.StringList{}
+	for _, s := range checks {
+		if _, ok := checksById[s]; !ok {
+			result = append(result, s)
+		}
+	}
+
+	return result.Sort()
+}
+
 func defaultChecks() []Check {
 	return []Check{
 		SchemaCheck,
@@ -0,0 +1,31 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/utils"
+)
+
+func TestConfig_AllChecks(t *testing.T) {
+	require.Len(t, lint.GetConfig(nil, nil).Checks, len(lint.GetCheckIds()))
+}
+
+func TestConfig_IncludeChecks(t *testing.T) {
+	const source = "../data/lint/info/no-info.yaml"
+	config := lint.GetConfig(utils.StringList{"info"}, nil)
+	require.Len(t, config.Checks, 1)
+	require.Len(t, lint.Run(*config, source, loadFrom(t, source)), 1)
+}
+
+func TestConfig_ExcludeChecks(t *testing.T) {
+	const source = "../data/lint/info/no-info.yaml"
+	config := lint.GetConfig(nil, utils.StringList{"info"})
+	require.Len(t, config.Checks, len(lint.GetCheckIds())-1)
+	require.Empty(t, lint.Run(*config, source, loadFrom(t, source)))
+}
+
+func TestConfig_ValidateChecks(t *testing.T) {
+	require.Equal(t, utils.StringList{"xxx"}, lint.ValidateChecks(utils.StringList{"info", "xxx"}))
+}
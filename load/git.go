@@ -0,0 +1,238 @@
+package load
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const gitPrefix = "git:"
+
+// GitLocation is a file in a revision of a local git repository, specified as git:<revision>:<path>
+// The path is relative to the current directory, like in other locations
+type GitLocation struct {
+	Revision string
+	Path     string
+}
+
+// IsGitLocation indicates whether the location refers to a file in a git revision
+func IsGitLocation(location string) bool {
+	return strings.HasPrefix(location, gitPrefix)
+}
+
+// ParseGitLocation parses a location in the format git:<revision>:<path>, for example: git:origin/main:api/openapi.yaml
+func ParseGitLocation(location string) (*GitLocation, error) {
+	if !IsGitLocation(location) {
+		return nil, fmt.Errorf("git location %q must start with %q", location, gitPrefix)
+	}
+
+	revision, filePath, found := strings.Cut(strings.TrimPrefix(location, gitPrefix), ":")
+	if !found || revision == "" || filePath == "" {
+		return nil, fmt.Errorf("git location %q must be in the format git:<revision>:<path>", location)
+	}
+	if err := checkRevision(revision); err != nil {
+		return nil, err
+	}
+
+	return &GitLocation{Revision: revision, Path: filePath}, nil
+}
+
+func (location GitLocation) String() string {
+	return gitPrefix + location.Revision + ":" + location.Path
+}
+
+// GitLoader loads specs from a revision of a local git repository
+// Relative external references are read from the same revision
+type GitLoader struct {
+	Revision string
+	// Dir is the directory in which git runs, relative paths are resolved from it; defaults to the current directory
+	Dir string
+	// Loader provides the settings of the loads, like the context and the reader of remote references; defaults to a new loader which allows external references
+	Loader *openapi3.Loader
+}
+
+// NewGitLoader returns a loader that reads specs from the given revision of the git repository in the current directory
+func NewGitLoader(revision string) *GitLoader {
+	return &GitLoader{Revision: revision}
+}
+
+// getGitLoader returns a git loader for the revision with the settings of the given loader
+// loaders which can't read from git, like custom implementations of Loader, are rejected rather than silently replaced
+func getGitLoader(loader Loader, revision string) (*GitLoader, error) {
+	switch loader := loader.(type) {
+	case *GitLoader:
+		return loader.withRevision(revision), nil
+	case *openapi3.Loader:
+		return &GitLoader{Revision: revision, Loader: loader}, nil
+	}
+	return nil, fmt.Errorf("git revision %q can't be loaded with %T", revision, loader)
+}
+
+// LoadFromFile loads a spec from a path in the revision
+func (gitLoader *GitLoader) LoadFromFile(location string) (*openapi3.T, error) {
+	return gitLoader.newLoader().LoadFromFile(location)
+}
+
+// LoadFromURI loads a spec from a git location, for example: git:origin/main:api/openapi.yaml
+// other URIs are loaded as usual, with relative references read from the revision
+func (gitLoader *GitLoader) LoadFromURI(location *url.URL) (*openapi3.T, error) {
+	if IsGitLocation(location.String()) {
+		gitLocation, err := ParseGitLocation(location.String())
+		if err != nil {
+			return nil, err
+		}
+		return gitLoader.withRevision(gitLocation.Revision).LoadFromFile(gitLocation.Path)
+	}
+	return gitLoader.newLoader().LoadFromURI(location)
+}
+
+// loadSpecInfo loads a spec from a path in the revision together with its locations, the file is read only once for both
+func (gitLoader *GitLoader) loadSpecInfo(filePath string) (*SpecInfo, error) {
+	location := GitLocation{Revision: gitLoader.Revision, Path: filePath}.String()
+
+	data, err := gitLoader.ReadFile(filePath)
+	if err != nil {
+		return &SpecInfo{Url: location}, err
+	}
+
+	loader := gitLoader.newLoader()
+	specURL := &url.URL{Path: filepath.ToSlash(filePath)}
+	spec, err := loader.LoadFromDataWithPath(NormalizeOpenAPI31(data), specURL)
+	specInfo := &SpecInfo{Url: location, Spec: spec, Locations: getLocations(location, data)}
+	if err != nil {
+		return specInfo, err
+	}
+	return specInfo, specInfo.parseOpenAPI31(loader, specURL)
+}
+
+// withRevision returns a loader with the same settings which reads from another revision
+func (gitLoader *GitLoader) withRevision(revision string) *GitLoader {
+	return &GitLoader{Revision: revision, Dir: gitLoader.Dir, Loader: gitLoader.Loader}
+}
+
+func (gitLoader *GitLoader) newLoader() *openapi3.Loader {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	if gitLoader.Loader != nil {
+		loader.IsExternalRefsAllowed = gitLoader.Loader.IsExternalRefsAllowed
+		loader.Context = gitLoader.Loader.Context
+	}
+	loader.ReadFromURIFunc = gitLoader.readFromURI
+	return loader
+}
+
+func (gitLoader *GitLoader) readFromURI(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	if location.Host != "" || (location.Scheme != "" && location.Scheme != "file") {
+		if gitLoader.Loader != nil && gitLoader.Loader.ReadFromURIFunc != nil {
+			return gitLoader.Loader.ReadFromURIFunc(loader, location)
+		}
+		return ReadFromURI(loader, location)
+	}
+	data, err := gitLoader.ReadFile(location.Path)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeOpenAPI31(data), nil
+}
+
+// ReadFile returns the contents of a file in the revision
+func (gitLoader *GitLoader) ReadFile(filePath string) ([]byte, error) {
+	if err := checkRevision(gitLoader.Revision); err != nil {
+		return nil, err
+	}
+	filePath = filepath.ToSlash(filePath)
+	if path.IsAbs(filePath) {
+		return nil, fmt.Errorf("git location path %q must be relative", filePath)
+	}
+	if !strings.HasPrefix(filePath, "./") && !strings.HasPrefix(filePath, "../") {
+		// git treats paths without these prefixes as relative to the repository root
+		filePath = "./" + filePath
+	}
+	return gitLoader.git("show", "--end-of-options", gitLoader.Revision+":"+filePath)
+}
+
+// Glob returns the paths of the files in the revision matching the pattern, relative to the current directory
+// Patterns follow the syntax of path.Match, and '**' matches any number of directories
+func (gitLoader *GitLoader) Glob(pattern string) ([]string, error) {
+	if err := checkRevision(gitLoader.Revision); err != nil {
+		return nil, err
+	}
+	pattern = filepath.ToSlash(pattern)
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	prefix, err := gitLoader.git("rev-parse", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	dir := strings.TrimSpace(string(prefix))
+
+	files, err := gitLoader.git("ls-tree", "-r", "--full-tree", "--name-only", "--end-of-options", gitLoader.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	rootPattern := strings.Split(path.Join(dir, pattern), "/")
+	result := []string{}
+	for _, file := range strings.Split(strings.TrimSpace(string(files)), "\n") {
+		if file == "" || !matchGlob(rootPattern, strings.Split(file, "/")) {
+			continue
+		}
+		relative, err := filepath.Rel(filepath.FromSlash(path.Join(".", dir)), filepath.FromSlash(file))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, filepath.ToSlash(relative))
+	}
+	return result, nil
+}
+
+// checkRevision rejects revisions which git would parse as options, like --output=<file>
+func checkRevision(revision string) error {
+	if strings.HasPrefix(revision, "-") {
+		return fmt.Errorf("git revision %q must not start with '-'", revision)
+	}
+	return nil
+}
+
+func (gitLoader *GitLoader) git(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = gitLoader.Dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s failed with %v: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
+
+func matchGlob(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchGlob(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], name[0]); !ok {
+		return false
+	}
+	return matchGlob(pattern[1:], name[1:])
+}
@@ -0,0 +1,199 @@
+package load_test
+
+import (
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+const gitSpec = `openapi: 3.0.0
+info:
+  title: test
+  version: "1"
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: "schemas/pet.yaml"
+`
+
+const gitSchema = `type: object
+properties:
+  name:
+    type: string
+`
+
+func newGitRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "api", "schemas"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "openapi.yaml"), []byte(gitSpec), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "schemas", "pet.yaml"), []byte(gitSchema), 0644))
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "test"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	// the working tree shouldn't affect the revision
+	require.NoError(t, os.Remove(filepath.Join(dir, "api", "schemas", "pet.yaml")))
+
+	return dir
+}
+
+func TestGit_ParseLocation(t *testing.T) {
+	location, err := load.ParseGitLocation("git:origin/main:api/openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, &load.GitLocation{Revision: "origin/main", Path: "api/openapi.yaml"}, location)
+	require.Equal(t, "git:origin/main:api/openapi.yaml", location.String())
+}
+
+func TestGit_ParseLocationInvalid(t *testing.T) {
+	_, err := load.ParseGitLocation("git:origin/main")
+	require.Error(t, err)
+
+	_, err = load.ParseGitLocation("git::api/openapi.yaml")
+	require.Error(t, err)
+
+	_, err = load.ParseGitLocation("api/openapi.yaml")
+	require.Error(t, err)
+
+	_, err = load.ParseGitLocation("git:--output=x:api/openapi.yaml")
+	require.Error(t, err)
+}
+
+func TestGit_LoadFromFile(t *testing.T) {
+	loader := &load.GitLoader{Revision: "HEAD", Dir: newGitRepo(t)}
+	spec, err := loader.LoadFromFile("api/openapi.yaml")
+	require.NoError(t, err)
+	schema := spec.Paths["/pets"].Get.Responses["200"].Value.Content["application/json"].Schema.Value
+	require.Contains(t, schema.Properties, "name")
+}
+
+func TestGit_LoadFromURI(t *testing.T) {
+	loader := &load.GitLoader{Revision: "xxx", Dir: newGitRepo(t)}
+	spec, err := loader.LoadFromURI(&url.URL{Scheme: "git", Opaque: "HEAD:api/openapi.yaml"})
+	require.NoError(t, err)
+	require.Contains(t, spec.Paths, "/pets")
+}
+
+func TestGit_LoadFromFileMissing(t *testing.T) {
+	loader := &load.GitLoader{Revision: "HEAD", Dir: newGitRepo(t)}
+	_, err := loader.LoadFromFile("api/xxx.yaml")
+	require.Error(t, err)
+}
+
+func TestGit_InvalidRevision(t *testing.T) {
+	loader := &load.GitLoader{Revision: "xxx", Dir: newGitRepo(t)}
+	_, err := loader.LoadFromFile("api/openapi.yaml")
+	require.Error(t, err)
+}
+
+func TestGit_OptionRevision(t *testing.T) {
+	dir := newGitRepo(t)
+	output := filepath.Join(dir, "output")
+	loader := &load.GitLoader{Revision: "--output=" + output, Dir: dir}
+
+	_, err := loader.LoadFromFile("api/openapi.yaml")
+	require.Error(t, err)
+
+	_, err = loader.Glob("**/*.yaml")
+	require.Error(t, err)
+
+	require.NoFileExists(t, output)
+}
+
+func TestGit_Glob(t *testing.T) {
+	loader := &load.GitLoader{Revision: "HEAD", Dir: newGitRepo(t)}
+
+	files, err := loader.Glob("api/*.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"api/openapi.yaml"}, files)
+
+	files, err = loader.Glob("**/*.yaml")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"api/openapi.yaml", "api/schemas/pet.yaml"}, files)
+}
+
+func TestGit_GlobInvalid(t *testing.T) {
+	loader := &load.GitLoader{Revision: "HEAD", Dir: newGitRepo(t)}
+	_, err := loader.Glob("[")
+	require.Error(t, err)
+}
+
+func TestGit_LoadSpecInfo(t *testing.T) {
+	dir := newGitRepo(t)
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(filepath.Join(dir, "api")))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	specInfo, err := load.LoadSpecInfo(loader, "git:HEAD:openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "git:HEAD:openapi.yaml", specInfo.Url)
+	location, ok := specInfo.Locations.Get("paths", "/pets", "get")
+	require.True(t, ok)
+	require.Equal(t, "git:HEAD:openapi.yaml:7:5", location.String())
+
+	specInfos, err := load.FromGlob(loader, "git:HEAD:*.yaml")
+	require.NoError(t, err)
+	require.Len(t, specInfos, 1)
+	require.Equal(t, "git:HEAD:openapi.yaml", specInfos[0].Url)
+	require.NotNil(t, specInfos[0].Locations)
+}
+
+func TestGit_LoadWithLoaderSettings(t *testing.T) {
+	dir := newGitRepo(t)
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(filepath.Join(dir, "api")))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	// the spec references another file, which the loader doesn't allow
+	_, err = load.From(openapi3.NewLoader(), "git:HEAD:openapi.yaml")
+	require.Error(t, err)
+
+	_, err = load.LoadSpecInfo(openapi3.NewLoader(), "git:HEAD:openapi.yaml")
+	require.Error(t, err)
+
+	// a git loader keeps its settings with the revision of the location
+	spec, err := load.From(&load.GitLoader{Revision: "xxx"}, "git:HEAD:openapi.yaml")
+	require.NoError(t, err)
+	require.Contains(t, spec.Paths, "/pets")
+}
+
+func TestGit_LoadWithCustomLoader(t *testing.T) {
+	_, err := load.From(MockLoader{}, "git:HEAD:openapi.yaml")
+	require.EqualError(t, err, `git revision "HEAD" can't be loaded with load_test.MockLoader`)
+}
@@ -12,9 +12,13 @@ type Loader interface {
 	LoadFromFile(string) (*openapi3.T, error)
 }
 
-// From is a convenience function that opens an OpenAPI spec from a URL or a local path based on the format of the path parameter
+// From is a convenience function that opens an OpenAPI spec from a URL, a git revision or a local path based on the format of the path parameter
 func From(loader Loader, path string) (*openapi3.T, error) {
 
+	if IsGitLocation(path) {
+		return loadFromGit(loader, path)
+	}
+
 	uri, err := url.ParseRequestURI(path)
 	if err == nil {
 		return loadFromURI(loader, uri)
@@ -31,3 +35,15 @@ func loadFromURI(loader Loader, uri *url.URL) (*openapi3.T, error) {
 	}
 	return oas, nil
 }
+
+func loadFromGit(loader Loader, location string) (*openapi3.T, error) {
+	gitLocation, err := ParseGitLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	gitLoader, err := getGitLoader(loader, gitLocation.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return gitLoader.LoadFromFile(gitLocation.Path)
+}
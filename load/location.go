@@ -0,0 +1,118 @@
+package load
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Location is a position of an element in a spec file
+type Location struct {
+	File   string `json:"file" yaml:"file"`
+	Line   int    `json:"line" yaml:"line"`
+	Column int    `json:"column" yaml:"column"`
+}
+
+// String returns the location in the format file:line:col
+func (location Location) String() string {
+	return fmt.Sprintf("%s:%d:%d", location.File, location.Line, location.Column)
+}
+
+// Locations maps JSON pointers (RFC 6901) of spec elements to their locations
+type Locations map[string]Location
+
+// Get returns the location of the element with the given reference tokens, for example: Get("paths", "/pets", "get")
+func (locations Locations) Get(tokens ...string) (Location, bool) {
+	location, ok := locations[JSONPointer(tokens...)]
+	return location, ok
+}
+
+// JSONPointer returns a JSON pointer from unescaped reference tokens
+func JSONPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+// GetLocations returns the locations of all the elements in a YAML or JSON spec
+// mapping entries are located at their key
+func GetLocations(file string, data []byte) (Locations, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	result := Locations{}
+	addLocations(result, file, "", &root)
+	return result, nil
+}
+
+// GetFileLocations returns the locations of all the elements in a local YAML or JSON spec file
+func GetFileLocations(file string) (Locations, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return GetLocations(file, data)
+}
+
+func addLocations(locations Locations, file string, pointer string, node *yaml.Node) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			locations[pointer] = Location{File: file, Line: child.Line, Column: child.Column}
+			addLocations(locations, file, pointer, child)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			childPointer := pointer + JSONPointer(key.Value)
+			locations[childPointer] = Location{File: file, Line: key.Line, Column: key.Column}
+			addLocations(locations, file, childPointer, value)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			childPointer := pointer + JSONPointer(strconv.Itoa(i))
+			locations[childPointer] = Location{File: file, Line: child.Line, Column: child.Column}
+			addLocations(locations, file, childPointer, child)
+		}
+	}
+}
+
+// RelativeLocation returns the location of a file referenced from the spec at the given location, like the file of an external $ref
+// like the loader, it resolves the file relative to the directory of the spec, in the same git revision or relative to the URL of the spec
+func RelativeLocation(location string, file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	if uri, err := url.Parse(file); err == nil && uri.Scheme != "" {
+		return file
+	}
+
+	if IsGitLocation(location) {
+		gitLocation, err := ParseGitLocation(location)
+		if err != nil {
+			return ""
+		}
+		return GitLocation{Revision: gitLocation.Revision, Path: path.Join(path.Dir(filepath.ToSlash(gitLocation.Path)), file)}.String()
+	}
+
+	if uri, err := url.ParseRequestURI(location); err == nil && uri.Scheme != "" {
+		ref, err := url.Parse(file)
+		if err != nil {
+			return ""
+		}
+		return uri.ResolveReference(ref).String()
+	}
+
+	return filepath.Join(filepath.Dir(location), file)
+}
@@ -0,0 +1,68 @@
+package load_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+const locationsSpec = `openapi: 3.0.0
+info:
+  title: test
+  version: "1"
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+`
+
+func TestLocations_YAML(t *testing.T) {
+	locations, err := load.GetLocations("spec.yaml", []byte(locationsSpec))
+	require.NoError(t, err)
+
+	location, ok := locations.Get("paths", "/pets/{id}", "get")
+	require.True(t, ok)
+	require.Equal(t, load.Location{File: "spec.yaml", Line: 7, Column: 5}, location)
+	require.Equal(t, "spec.yaml:7:5", location.String())
+
+	location, ok = locations.Get("paths", "/pets/{id}", "get", "parameters", "0")
+	require.True(t, ok)
+	require.Equal(t, 9, location.Line)
+	require.Equal(t, 11, location.Column)
+}
+
+func TestLocations_JSON(t *testing.T) {
+	locations, err := load.GetLocations("spec.json", []byte("{\n  \"paths\": {\n    \"/a\": {\n      \"get\": {}\n    }\n  }\n}"))
+	require.NoError(t, err)
+
+	location, ok := locations.Get("paths", "/a", "get")
+	require.True(t, ok)
+	require.Equal(t, 4, location.Line)
+	require.Equal(t, 7, location.Column)
+}
+
+func TestLocations_Invalid(t *testing.T) {
+	_, err := load.GetLocations("spec.yaml", []byte("a: [b"))
+	require.Error(t, err)
+}
+
+func TestLocations_JSONPointer(t *testing.T) {
+	require.Equal(t, "/paths/~1a~0b~1{id}/get", load.JSONPointer("paths", "/a~b/{id}", "get"))
+}
+
+func TestLocations_LoadSpecInfo(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(openapi3.NewLoader(), "../data/openapi-test1.yaml")
+	require.NoError(t, err)
+	_, ok := specInfo.Locations.Get("paths", "/api/{domain}/{project}/badges/security-score", "get")
+	require.True(t, ok)
+}
+
+func TestLocations_LoadSpecInfoURI(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(MockLoader{}, "http://localhost/openapi-test1.yaml")
+	require.NoError(t, err)
+	require.Nil(t, specInfo.Locations)
+}
@@ -0,0 +1,174 @@
+package load
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+// TypesExtension holds the types of a JSON Schema 2020-12 type array with several non-null types, which can't be represented in kin-openapi
+const TypesExtension = "x-oasdiff-types"
+
+// keys whose values are instances rather than schemas, so they must not be normalized
+var instanceKeys = map[string]struct{}{
+	"example":  {},
+	"examples": {},
+	"default":  {},
+	"enum":     {},
+	"const":    {},
+}
+
+// ReadFromURI reads a spec like openapi3.DefaultReadFromURI and normalizes the OpenAPI 3.1 keywords which kin-openapi can't parse
+func ReadFromURI(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	data, err := openapi3.DefaultReadFromURI(loader, location)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeOpenAPI31(data), nil
+}
+
+/*
+NormalizeOpenAPI31 rewrites the JSON Schema 2020-12 keywords of OpenAPI 3.1 which kin-openapi can't parse into their OpenAPI 3.0 equivalents:
+- in a type array, "null" is replaced by "nullable: true" and a single remaining type becomes the type of the schema, several remaining types are kept in TypesExtension
+- numeric exclusiveMinimum and exclusiveMaximum become minimum and maximum together with the boolean exclusiveMinimum and exclusiveMaximum
+
+Other 2020-12 keywords, like const and prefixItems, are loaded by kin-openapi as extensions of the schema.
+Their subschemas are parsed when the spec is loaded into a SpecInfo, see parseSchemaKeywords.
+The data is returned unchanged if it can't be parsed or doesn't contain any of these keywords.
+*/
+func NormalizeOpenAPI31(data []byte) []byte {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return data
+	}
+
+	if !normalizeNode(&root) {
+		return data
+	}
+
+	result, err := yaml.Marshal(&root)
+	if err != nil {
+		return data
+	}
+	return result
+}
+
+// normalizeNode normalizes the node and its children and returns true if anything was changed
+func normalizeNode(node *yaml.Node) bool {
+	changed := false
+
+	if node.Kind == yaml.MappingNode {
+		changed = normalizeTypeArray(node) || changed
+		changed = normalizeExclusiveBound(node, "exclusiveMinimum", "minimum", func(bound, limit float64) bool { return bound >= limit }) || changed
+		changed = normalizeExclusiveBound(node, "exclusiveMaximum", "maximum", func(bound, limit float64) bool { return bound <= limit }) || changed
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if _, ok := instanceKeys[key]; ok || strings.HasPrefix(key, "x-") {
+				continue
+			}
+			changed = normalizeNode(node.Content[i+1]) || changed
+		}
+		return changed
+	}
+
+	for _, child := range node.Content {
+		changed = normalizeNode(child) || changed
+	}
+	return changed
+}
+
+func normalizeTypeArray(node *yaml.Node) bool {
+	index := findKey(node, "type")
+	if index < 0 || node.Content[index+1].Kind != yaml.SequenceNode {
+		return false
+	}
+
+	nullable := false
+	types := []*yaml.Node{}
+	for _, typeNode := range node.Content[index+1].Content {
+		if typeNode.Value == "null" {
+			nullable = true
+			continue
+		}
+		types = append(types, typeNode)
+	}
+
+	switch len(types) {
+	case 0:
+		removeKey(node, index)
+	case 1:
+		node.Content[index+1] = types[0]
+	default:
+		removeKey(node, index)
+		setKey(node, TypesExtension, &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: types})
+	}
+
+	if nullable {
+		setKey(node, "nullable", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"})
+	}
+
+	return true
+}
+
+// normalizeExclusiveBound replaces a numeric exclusive bound by a limit and a boolean exclusive bound
+// if the schema also has a stricter limit, the exclusive bound is dropped since it doesn't change the range
+func normalizeExclusiveBound(node *yaml.Node, boundKey string, limitKey string, isStricter func(bound, limit float64) bool) bool {
+	index := findKey(node, boundKey)
+	if index < 0 {
+		return false
+	}
+
+	boundNode := node.Content[index+1]
+	bound, ok := getNumber(boundNode)
+	if !ok {
+		return false
+	}
+
+	if limitIndex := findKey(node, limitKey); limitIndex >= 0 {
+		if limit, ok := getNumber(node.Content[limitIndex+1]); ok && !isStricter(bound, limit) {
+			removeKey(node, index)
+			return true
+		}
+	}
+
+	node.Content[index+1] = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+	setKey(node, limitKey, boundNode)
+	return true
+}
+
+func getNumber(node *yaml.Node) (float64, bool) {
+	if node.Kind != yaml.ScalarNode || (node.Tag != "!!int" && node.Tag != "!!float") {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(node.Value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// findKey returns the index of the key in a mapping node, or -1 if it isn't found
+func findKey(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeKey(node *yaml.Node, index int) {
+	node.Content = append(node.Content[:index], node.Content[index+2:]...)
+}
+
+func setKey(node *yaml.Node, key string, value *yaml.Node) {
+	if index := findKey(node, key); index >= 0 {
+		node.Content[index+1] = value
+		return
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
@@ -0,0 +1,96 @@
+package load_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+func loadNormalizedSchema(t *testing.T, schema string) *openapi3.Schema {
+	t.Helper()
+
+	data := load.NormalizeOpenAPI31([]byte(`
+openapi: 3.1.0
+info:
+  title: test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    test:
+` + schema))
+
+	spec, err := openapi3.NewLoader().LoadFromData(data)
+	require.NoError(t, err)
+	return spec.Components.Schemas["test"].Value
+}
+
+func TestNormalize_TypeArrayWithNull(t *testing.T) {
+	schema := loadNormalizedSchema(t, `      type: [string, "null"]`)
+	require.Equal(t, "string", schema.Type)
+	require.True(t, schema.Nullable)
+	require.NotContains(t, schema.Extensions, load.TypesExtension)
+}
+
+func TestNormalize_TypeArraySingle(t *testing.T) {
+	schema := loadNormalizedSchema(t, `      type: [integer]`)
+	require.Equal(t, "integer", schema.Type)
+	require.False(t, schema.Nullable)
+}
+
+func TestNormalize_TypeArrayMultiple(t *testing.T) {
+	schema := loadNormalizedSchema(t, `      type: [string, integer, "null"]`)
+	require.Empty(t, schema.Type)
+	require.True(t, schema.Nullable)
+	require.Equal(t, []interface{}{"string", "integer"}, schema.Extensions[load.TypesExtension])
+}
+
+func TestNormalize_ExclusiveMinimum(t *testing.T) {
+	schema := loadNormalizedSchema(t, `      type: integer
+      exclusiveMinimum: 5
+      exclusiveMaximum: 10`)
+	require.Equal(t, 5.0, *schema.Min)
+	require.True(t, schema.ExclusiveMin)
+	require.Equal(t, 10.0, *schema.Max)
+	require.True(t, schema.ExclusiveMax)
+}
+
+func TestNormalize_ExclusiveMinimumWithStricterMinimum(t *testing.T) {
+	schema := loadNormalizedSchema(t, `      type: integer
+      minimum: 10
+      exclusiveMinimum: 5`)
+	require.Equal(t, 10.0, *schema.Min)
+	require.False(t, schema.ExclusiveMin)
+}
+
+func TestNormalize_NestedAndInstances(t *testing.T) {
+	schema := loadNormalizedSchema(t, `      type: object
+      properties:
+        type:
+          type: [boolean, "null"]
+      example:
+        type: [a, b]`)
+	require.Equal(t, "boolean", schema.Properties["type"].Value.Type)
+	require.True(t, schema.Properties["type"].Value.Nullable)
+	require.Equal(t, map[string]interface{}{"type": []interface{}{"a", "b"}}, schema.Example)
+}
+
+func TestNormalize_Unchanged(t *testing.T) {
+	data := []byte("openapi: 3.0.0\n# comment\n")
+	require.Equal(t, data, load.NormalizeOpenAPI31(data))
+}
+
+func TestNormalize_JSON(t *testing.T) {
+	data := load.NormalizeOpenAPI31([]byte(`{"openapi": "3.1.0", "info": {"title": "test", "version": "1.0.0"}, "paths": {},
+"components": {"schemas": {"test": {"type": ["number", "null"], "exclusiveMaximum": 1.5}}}}`))
+
+	spec, err := openapi3.NewLoader().LoadFromData(data)
+	require.NoError(t, err)
+	schema := spec.Components.Schemas["test"].Value
+	require.Equal(t, "number", schema.Type)
+	require.True(t, schema.Nullable)
+	require.Equal(t, 1.5, *schema.Max)
+	require.True(t, schema.ExclusiveMax)
+}
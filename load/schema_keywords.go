@@ -0,0 +1,315 @@
+package load
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// JSON Schema 2020-12 keywords which are used in OpenAPI 3.1: https://json-schema.org/draft/2020-12/json-schema-core
+// kin-openapi doesn't support OpenAPI 3.1 yet, so these keywords are loaded as extensions of the schema
+const (
+	KeywordConst                 = "const"
+	KeywordPrefixItems           = "prefixItems"
+	KeywordDependentRequired     = "dependentRequired"
+	KeywordIf                    = "if"
+	KeywordThen                  = "then"
+	KeywordElse                  = "else"
+	KeywordDefs                  = "$defs"
+	KeywordUnevaluatedProperties = "unevaluatedProperties"
+)
+
+/*
+parseSchemaKeywords parses the subschemas of the JSON Schema 2020-12 keywords of the schemas in the spec and in its webhooks
+the raw values of the keywords are replaced by typed subschemas:
+- KeywordIf, KeywordThen, KeywordElse and a KeywordUnevaluatedProperties schema by *openapi3.SchemaRef
+- KeywordPrefixItems by openapi3.SchemaRefs
+- KeywordDefs by openapi3.Schemas
+the references in the subschemas are resolved like the ones in the spec: against its components and relative to its location
+*/
+func parseSchemaKeywords(loader *openapi3.Loader, spec *openapi3.T, webhooks openapi3.Paths, location *url.URL) error {
+	if spec == nil {
+		return nil
+	}
+
+	parser := keywordsParser{visited: map[*openapi3.Schema]struct{}{}}
+	parser.walkComponents(spec.Components)
+	for _, pathItem := range spec.Paths {
+		parser.walkPathItem(pathItem)
+	}
+	for _, pathItem := range webhooks {
+		parser.walkPathItem(pathItem)
+	}
+
+	for parser.err == nil && len(parser.parsed) > 0 {
+		parsed := parser.parsed
+		parser.parsed = nil
+		if err := resolveSchemaRefs(loader, spec, parsed, location); err != nil {
+			return err
+		}
+		// the referenced schemas, like the ones in other files, may have keywords of their own
+		for _, schemaRef := range parsed {
+			parser.walkSchemaRef(schemaRef)
+		}
+	}
+
+	return parser.err
+}
+
+// resolveSchemaRefs resolves the references in parsed subschemas in a document which shares the components of the spec
+func resolveSchemaRefs(loader *openapi3.Loader, spec *openapi3.T, schemaRefs openapi3.SchemaRefs, location *url.URL) error {
+	components := openapi3.Components{}
+	if spec.Components != nil {
+		components = *spec.Components
+	}
+
+	components.Schemas = openapi3.Schemas{}
+	if spec.Components != nil {
+		for name, schemaRef := range spec.Components.Schemas {
+			components.Schemas[name] = schemaRef
+		}
+	}
+	for i, schemaRef := range schemaRefs {
+		components.Schemas[fmt.Sprintf("oasdiff-keyword-%d", i)] = schemaRef
+	}
+
+	doc := &openapi3.T{
+		OpenAPI:    spec.OpenAPI,
+		Info:       spec.Info,
+		Components: &components,
+		Paths:      openapi3.Paths{},
+	}
+	if err := loader.ResolveRefsIn(doc, location); err != nil {
+		return fmt.Errorf("failed to resolve references in JSON Schema keywords: %w", err)
+	}
+
+	return nil
+}
+
+// keywordsParser walks the schemas of a spec, parses the subschemas of their keywords and collects the ones which need to be resolved
+type keywordsParser struct {
+	visited map[*openapi3.Schema]struct{}
+	parsed  openapi3.SchemaRefs
+	err     error
+}
+
+func (parser *keywordsParser) walkComponents(components *openapi3.Components) {
+	if components == nil {
+		return
+	}
+	for _, schemaRef := range components.Schemas {
+		parser.walkSchemaRef(schemaRef)
+	}
+	for _, parameterRef := range components.Parameters {
+		if parameterRef != nil {
+			parser.walkParameter(parameterRef.Value)
+		}
+	}
+	for _, headerRef := range components.Headers {
+		if headerRef != nil {
+			parser.walkHeader(headerRef.Value)
+		}
+	}
+	for _, requestBodyRef := range components.RequestBodies {
+		if requestBodyRef != nil && requestBodyRef.Value != nil {
+			parser.walkContent(requestBodyRef.Value.Content)
+		}
+	}
+	for _, responseRef := range components.Responses {
+		if responseRef != nil {
+			parser.walkResponse(responseRef.Value)
+		}
+	}
+	for _, callbackRef := range components.Callbacks {
+		if callbackRef != nil {
+			parser.walkCallback(callbackRef.Value)
+		}
+	}
+}
+
+func (parser *keywordsParser) walkPathItem(pathItem *openapi3.PathItem) {
+	if pathItem == nil {
+		return
+	}
+	for _, parameterRef := range pathItem.Parameters {
+		if parameterRef != nil {
+			parser.walkParameter(parameterRef.Value)
+		}
+	}
+	for _, operation := range pathItem.Operations() {
+		parser.walkOperation(operation)
+	}
+}
+
+func (parser *keywordsParser) walkOperation(operation *openapi3.Operation) {
+	for _, parameterRef := range operation.Parameters {
+		if parameterRef != nil {
+			parser.walkParameter(parameterRef.Value)
+		}
+	}
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		parser.walkContent(operation.RequestBody.Value.Content)
+	}
+	for _, responseRef := range operation.Responses {
+		if responseRef != nil {
+			parser.walkResponse(responseRef.Value)
+		}
+	}
+	for _, callbackRef := range operation.Callbacks {
+		if callbackRef != nil {
+			parser.walkCallback(callbackRef.Value)
+		}
+	}
+}
+
+func (parser *keywordsParser) walkCallback(callback *openapi3.Callback) {
+	if callback == nil {
+		return
+	}
+	for _, pathItem := range *callback {
+		parser.walkPathItem(pathItem)
+	}
+}
+
+func (parser *keywordsParser) walkParameter(parameter *openapi3.Parameter) {
+	if parameter == nil {
+		return
+	}
+	parser.walkSchemaRef(parameter.Schema)
+	parser.walkContent(parameter.Content)
+}
+
+func (parser *keywordsParser) walkResponse(response *openapi3.Response) {
+	if response == nil {
+		return
+	}
+	for _, headerRef := range response.Headers {
+		if headerRef != nil {
+			parser.walkHeader(headerRef.Value)
+		}
+	}
+	parser.walkContent(response.Content)
+}
+
+func (parser *keywordsParser) walkHeader(header *openapi3.Header) {
+	if header == nil {
+		return
+	}
+	parser.walkSchemaRef(header.Schema)
+	parser.walkContent(header.Content)
+}
+
+func (parser *keywordsParser) walkContent(content openapi3.Content) {
+	for _, mediaType := range content {
+		if mediaType != nil {
+			parser.walkSchemaRef(mediaType.Schema)
+		}
+	}
+}
+
+func (parser *keywordsParser) walkSchemaRef(schemaRef *openapi3.SchemaRef) {
+	if schemaRef != nil {
+		parser.walkSchema(schemaRef.Value)
+	}
+}
+
+func (parser *keywordsParser) walkSchema(schema *openapi3.Schema) {
+	if schema == nil || parser.err != nil {
+		return
+	}
+	if _, ok := parser.visited[schema]; ok {
+		return
+	}
+	parser.visited[schema] = struct{}{}
+
+	if parser.err = parser.parseKeywords(schema); parser.err != nil {
+		return
+	}
+
+	for _, property := range schema.Properties {
+		parser.walkSchemaRef(property)
+	}
+	parser.walkSchemaRef(schema.Items)
+	parser.walkSchemaRef(schema.AdditionalProperties.Schema)
+	parser.walkSchemaRef(schema.Not)
+	for _, schemaRefs := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, schemaRef := range schemaRefs {
+			parser.walkSchemaRef(schemaRef)
+		}
+	}
+
+	for _, keyword := range []string{KeywordIf, KeywordThen, KeywordElse, KeywordUnevaluatedProperties} {
+		if schemaRef, ok := schema.Extensions[keyword].(*openapi3.SchemaRef); ok {
+			parser.walkSchemaRef(schemaRef)
+		}
+	}
+	if schemaRefs, ok := schema.Extensions[KeywordPrefixItems].(openapi3.SchemaRefs); ok {
+		for _, schemaRef := range schemaRefs {
+			parser.walkSchemaRef(schemaRef)
+		}
+	}
+	if schemas, ok := schema.Extensions[KeywordDefs].(openapi3.Schemas); ok {
+		for _, schemaRef := range schemas {
+			parser.walkSchemaRef(schemaRef)
+		}
+	}
+}
+
+// parseKeywords replaces the raw values of the keywords of the schema by typed subschemas
+func (parser *keywordsParser) parseKeywords(schema *openapi3.Schema) error {
+	// keywords which are already parsed, like in schemas of other files which are shared by several specs, are kept
+	for _, keyword := range []string{KeywordIf, KeywordThen, KeywordElse, KeywordUnevaluatedProperties} {
+		switch value := schema.Extensions[keyword].(type) {
+		case nil, bool, *openapi3.SchemaRef:
+			// unevaluatedProperties is either a boolean or a schema
+			continue
+		default:
+			result := &openapi3.SchemaRef{}
+			if err := convertKeyword(keyword, value, result); err != nil {
+				return err
+			}
+			schema.Extensions[keyword] = result
+			parser.parsed = append(parser.parsed, result)
+		}
+	}
+
+	switch value := schema.Extensions[KeywordPrefixItems].(type) {
+	case nil, openapi3.SchemaRefs:
+	default:
+		result := openapi3.SchemaRefs{}
+		if err := convertKeyword(KeywordPrefixItems, value, &result); err != nil {
+			return err
+		}
+		schema.Extensions[KeywordPrefixItems] = result
+		parser.parsed = append(parser.parsed, result...)
+	}
+
+	switch value := schema.Extensions[KeywordDefs].(type) {
+	case nil, openapi3.Schemas:
+	default:
+		result := openapi3.Schemas{}
+		if err := convertKeyword(KeywordDefs, value, &result); err != nil {
+			return err
+		}
+		schema.Extensions[KeywordDefs] = result
+		for _, schemaRef := range result {
+			parser.parsed = append(parser.parsed, schemaRef)
+		}
+	}
+
+	return nil
+}
+
+// convertKeyword converts the raw value of a keyword, as loaded by kin-openapi, to a typed value
+func convertKeyword(keyword string, value interface{}, result interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", keyword, err)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("failed to read %q: %w", keyword, err)
+	}
+	return nil
+}
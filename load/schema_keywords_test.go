@@ -0,0 +1,61 @@
+package load_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+func loadSchemaKeywordsSpec(t *testing.T, loader *openapi3.Loader, file string) *openapi3.T {
+	t.Helper()
+	loader.ReadFromURIFunc = load.ReadFromURI
+	specInfo, err := load.LoadSpecInfoFromFile(loader, file)
+	require.NoError(t, err)
+	return specInfo.Spec
+}
+
+func TestSchemaKeywords_Parsed(t *testing.T) {
+	spec := loadSchemaKeywordsSpec(t, openapi3.NewLoader(), "../data/openapi31/schema-keywords-base.yaml")
+	pet := spec.Components.Schemas["Pet"].Value
+
+	require.IsType(t, &openapi3.SchemaRef{}, pet.Extensions[load.KeywordIf])
+	require.Equal(t, []string{"license"}, pet.Extensions[load.KeywordThen].(*openapi3.SchemaRef).Value.Required)
+	require.Equal(t, "string", pet.Extensions[load.KeywordDefs].(openapi3.Schemas)["tag"].Value.Type)
+	require.Equal(t, false, pet.Extensions[load.KeywordUnevaluatedProperties])
+
+	// the keywords of the parsed subschemas are kept as they are
+	kind := pet.Extensions[load.KeywordIf].(*openapi3.SchemaRef).Value.Properties["kind"].Value
+	require.Equal(t, "dog", kind.Extensions[load.KeywordConst])
+}
+
+func TestSchemaKeywords_Refs(t *testing.T) {
+	spec := loadSchemaKeywordsSpec(t, openapi3.NewLoader(), "../data/openapi31/schema-keywords-base.yaml")
+	position := spec.Components.Schemas["Pet"].Value.Properties["position"].Value
+
+	prefixItems := position.Extensions[load.KeywordPrefixItems].(openapi3.SchemaRefs)
+	require.Len(t, prefixItems, 2)
+	require.Equal(t, "#/components/schemas/Coordinate", prefixItems[1].Ref)
+	require.Same(t, spec.Components.Schemas["Coordinate"].Value, prefixItems[1].Value)
+}
+
+func TestSchemaKeywords_ExternalRefs(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	spec := loadSchemaKeywordsSpec(t, loader, "../data/openapi31/external/spec.yaml")
+
+	prefixItems := spec.Components.Schemas["Position"].Value.Extensions[load.KeywordPrefixItems].(openapi3.SchemaRefs)
+	coordinate := prefixItems[1].Value
+	require.NotNil(t, coordinate)
+
+	// the keywords of schemas in other files are parsed too
+	require.Equal(t, []string{"value"}, coordinate.Extensions[load.KeywordIf].(*openapi3.SchemaRef).Value.Required)
+}
+
+func TestSchemaKeywords_ExternalRefsNotAllowed(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.ReadFromURIFunc = load.ReadFromURI
+	_, err := load.LoadSpecInfoFromFile(loader, "../data/openapi31/external/spec.yaml")
+	require.Error(t, err)
+}
@@ -1,29 +1,51 @@
 package load
 
 import (
+	"net/url"
+	"path/filepath"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/yargevad/filepathx"
 )
 
 type SpecInfo struct {
-	Url  string
-	Spec *openapi3.T
+	Url       string
+	Spec      *openapi3.T
+	Locations Locations
+	// Webhooks are the webhooks of an OpenAPI 3.1 spec, which kin-openapi loads as an extension of the spec
+	Webhooks openapi3.Paths
 }
 
 // LoadSpecInfoFromFile creates a SpecInfo from a local file path
 func LoadSpecInfoFromFile(loader Loader, location string) (*SpecInfo, error) {
 	s, err := loader.LoadFromFile(location)
-	return &SpecInfo{Spec: s, Url: location}, err
+	specInfo := &SpecInfo{Spec: s, Url: location, Locations: getFileLocations(location)}
+	if err != nil {
+		return specInfo, err
+	}
+	return specInfo, specInfo.parseOpenAPI31(getOpenAPILoader(loader), getSpecURL(location))
 }
 
-// LoadSpecInfo creates a SpecInfo from a local file path or a URL
+// LoadSpecInfo creates a SpecInfo from a local file path, a git revision or a URL
 func LoadSpecInfo(loader Loader, location string) (*SpecInfo, error) {
+	if IsGitLocation(location) {
+		return loadGitSpecInfo(loader, location)
+	}
+
 	s, err := From(loader, location)
-	return &SpecInfo{Spec: s, Url: location}, err
+	specInfo := &SpecInfo{Spec: s, Url: location, Locations: GetLocationsFrom(location)}
+	if err != nil {
+		return specInfo, err
+	}
+	return specInfo, specInfo.parseOpenAPI31(getOpenAPILoader(loader), getSpecURL(location))
 }
 
-// FromGlob creates SpecInfo specs from local files matching the specified glob parameter
+// FromGlob creates SpecInfo specs from local files, or files in a git revision, matching the specified glob parameter
 func FromGlob(loader Loader, glob string) ([]SpecInfo, error) {
+	if IsGitLocation(glob) {
+		return fromGitGlob(loader, glob)
+	}
+
 	files, err := filepathx.Glob(glob)
 	if err != nil {
 		return nil, err
@@ -34,8 +56,124 @@ func FromGlob(loader Loader, glob string) ([]SpecInfo, error) {
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, SpecInfo{Url: file, Spec: spec})
+		specInfo := SpecInfo{Url: file, Spec: spec, Locations: getFileLocations(file)}
+		if err := specInfo.parseOpenAPI31(getOpenAPILoader(loader), getSpecURL(file)); err != nil {
+			return nil, err
+		}
+		result = append(result, specInfo)
 	}
 
 	return result, nil
 }
+
+func loadGitSpecInfo(loader Loader, location string) (*SpecInfo, error) {
+	gitLocation, err := ParseGitLocation(location)
+	if err != nil {
+		return &SpecInfo{Url: location}, err
+	}
+
+	gitLoader, err := getGitLoader(loader, gitLocation.Revision)
+	if err != nil {
+		return &SpecInfo{Url: location}, err
+	}
+	return gitLoader.loadSpecInfo(gitLocation.Path)
+}
+
+func fromGitGlob(loader Loader, glob string) ([]SpecInfo, error) {
+	gitLocation, err := ParseGitLocation(glob)
+	if err != nil {
+		return nil, err
+	}
+
+	gitLoader, err := getGitLoader(loader, gitLocation.Revision)
+	if err != nil {
+		return nil, err
+	}
+	files, err := gitLoader.Glob(gitLocation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SpecInfo, 0)
+	for _, file := range files {
+		specInfo, err := gitLoader.loadSpecInfo(file)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *specInfo)
+	}
+
+	return result, nil
+}
+
+// parseOpenAPI31 parses the OpenAPI 3.1 elements of the spec which kin-openapi loads as extensions: the webhooks and the subschemas of the JSON Schema 2020-12 keywords
+// the references in them are resolved by the loader of the spec, relative to its location
+func (specInfo *SpecInfo) parseOpenAPI31(loader *openapi3.Loader, location *url.URL) error {
+	webhooks, err := getWebhooks(loader, specInfo.Spec, location)
+	if err != nil {
+		return err
+	}
+	specInfo.Webhooks = webhooks
+	return parseSchemaKeywords(loader, specInfo.Spec, webhooks, location)
+}
+
+// getOpenAPILoader returns the kin-openapi loader which resolves the references in the OpenAPI 3.1 elements of a spec loaded by the given loader
+func getOpenAPILoader(loader Loader) *openapi3.Loader {
+	switch loader := loader.(type) {
+	case *openapi3.Loader:
+		return loader
+	case *GitLoader:
+		return loader.newLoader()
+	}
+	return openapi3.NewLoader()
+}
+
+// getSpecURL returns the URL of a spec loaded from a local file path or a URL, see From
+func getSpecURL(location string) *url.URL {
+	if uri, err := url.ParseRequestURI(location); err == nil {
+		return uri
+	}
+	return &url.URL{Path: filepath.ToSlash(location)}
+}
+
+// GetLocationsFrom returns the element locations of a local spec file or a file in a git revision, or nil for a URL or if they can't be determined
+func GetLocationsFrom(location string) Locations {
+	if IsGitLocation(location) {
+		return getGitLocations(location)
+	}
+	if _, err := url.ParseRequestURI(location); err == nil {
+		return nil
+	}
+	return getFileLocations(location)
+}
+
+// getGitLocations returns the element locations of a file in a git revision, or nil if they can't be determined
+func getGitLocations(location string) Locations {
+	gitLocation, err := ParseGitLocation(location)
+	if err != nil {
+		return nil
+	}
+	data, err := NewGitLoader(gitLocation.Revision).ReadFile(gitLocation.Path)
+	if err != nil {
+		return nil
+	}
+	return getLocations(location, data)
+}
+
+// getLocations returns the element locations of the contents of a spec file, or nil if they can't be determined
+func getLocations(location string, data []byte) Locations {
+	locations, err := GetLocations(location, data)
+	if err != nil {
+		return nil
+	}
+	return locations
+}
+
+// getFileLocations returns the element locations of a local spec file, or nil if they can't be determined
+func getFileLocations(file string) Locations {
+	locations, err := GetFileLocations(file)
+	if err != nil {
+		return nil
+	}
+	return locations
+}
@@ -0,0 +1,50 @@
+package load
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// webhooksField is the OpenAPI 3.1 field which contains the webhooks: https://spec.openapis.org/oas/v3.1.0#fixed-fields
+// kin-openapi doesn't support OpenAPI 3.1 yet, so the webhooks are loaded as an extension of the spec
+const webhooksField = "webhooks"
+
+// getWebhooks parses the webhooks of an OpenAPI 3.1 spec into a map of webhook names to path items
+// the references in the webhooks are resolved like the ones in the spec: against its components and relative to its location
+// the spec isn't modified, the webhooks remain an extension of it
+func getWebhooks(loader *openapi3.Loader, spec *openapi3.T, location *url.URL) (openapi3.Paths, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	raw, ok := spec.Extensions[webhooksField]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhooks: %w", err)
+	}
+
+	webhooks := openapi3.Paths{}
+	if err := json.Unmarshal(data, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to read webhooks: %w", err)
+	}
+
+	// resolve the references in a document which shares the components of the spec
+	doc := &openapi3.T{
+		OpenAPI:    spec.OpenAPI,
+		Info:       spec.Info,
+		Components: spec.Components,
+		Paths:      webhooks,
+	}
+	if err := loader.ResolveRefsIn(doc, location); err != nil {
+		return nil, fmt.Errorf("failed to resolve references in webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
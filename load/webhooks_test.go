@@ -0,0 +1,40 @@
+package load_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+func TestWebhooks_Load(t *testing.T) {
+	specInfo, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), "../data/webhooks/spec_1.yaml")
+	require.NoError(t, err)
+	require.Len(t, specInfo.Webhooks, 2)
+	require.Same(t, specInfo.Spec.Components.Schemas["Pet"].Value, specInfo.Webhooks["newPet"].Post.RequestBody.Value.Content["application/json"].Schema.Value)
+
+	// the webhooks remain an extension of the spec
+	_, ok := specInfo.Spec.Extensions["webhooks"].(openapi3.Paths)
+	require.False(t, ok)
+}
+
+func TestWebhooks_ExternalRefs(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	specInfo, err := load.LoadSpecInfo(loader, "../data/webhooks/external/spec.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"id"}, specInfo.Webhooks["newPet"].Post.RequestBody.Value.Content["application/json"].Schema.Value.Required)
+}
+
+func TestWebhooks_ExternalRefsNotAllowed(t *testing.T) {
+	_, err := load.LoadSpecInfo(openapi3.NewLoader(), "../data/webhooks/external/spec.yaml")
+	require.Error(t, err)
+}
+
+func TestWebhooks_None(t *testing.T) {
+	specInfo, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), "../data/openapi-test1.yaml")
+	require.NoError(t, err)
+	require.Nil(t, specInfo.Webhooks)
+}
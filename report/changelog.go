@@ -0,0 +1,216 @@
+package report
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/checker/localizations"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// the ids of the changelog sections, in the order in which they are displayed
+const (
+	ChangelogBreakingChanges = "breaking-changes"
+	ChangelogWarnings        = "warnings"
+	ChangelogDeprecations    = "deprecations"
+	ChangelogAdditions       = "additions"
+	ChangelogOtherChanges    = "other-changes"
+)
+
+var changelogSectionIds = []string{
+	ChangelogBreakingChanges,
+	ChangelogWarnings,
+	ChangelogDeprecations,
+	ChangelogAdditions,
+	ChangelogOtherChanges,
+}
+
+//go:embed templates/changelog.md.tmpl
+var defaultChangelogTemplate string
+
+// Changelog is the data model of the markdown and HTML changelogs, which is also passed to custom changelog templates
+type Changelog struct {
+	Title    string
+	Sections []*ChangelogSection // only sections with changes are listed
+}
+
+// ChangelogSection groups the changes of the same kind by endpoint: breaking changes (ERR), potential breaking changes (WARN), deprecations, additions and other changes (INFO)
+type ChangelogSection struct {
+	Id        string
+	Title     string
+	Endpoints []*ChangelogEndpoint
+}
+
+// ChangelogEndpoint lists the changes in an endpoint, ordered by level
+type ChangelogEndpoint struct {
+	Operation   string // the method, or N/A for changes which don't belong to an operation
+	Path        string
+	OperationId string
+	Deprecated  bool   // the endpoint is deprecated
+	Sunset      string // the sunset date of the endpoint, if it is deprecated
+	Changes     []*ChangelogChange
+}
+
+// ChangelogChange is a single change in an endpoint
+type ChangelogChange struct {
+	Id      string
+	Level   string // error, warning or info
+	Text    string
+	Comment string
+}
+
+// Name returns the method and path of the endpoint, or only the path for changes which don't belong to an operation
+func (endpoint *ChangelogEndpoint) Name() string {
+	if endpoint.Operation == "" || endpoint.Operation == "N/A" {
+		return endpoint.Path
+	}
+	return endpoint.Operation + " " + endpoint.Path
+}
+
+// GetChangelog groups the changes by kind and by endpoint
+// the diff is used to find the deprecation and sunset dates of the endpoints
+func GetChangelog(errs checker.BackwardCompatibilityErrors, d *diff.Diff, l localizations.Localizer) *Changelog {
+	sections := map[string]*ChangelogSection{}
+	endpoints := map[string]map[diff.Endpoint]*ChangelogEndpoint{}
+
+	for _, err := range errs {
+		sectionId := getChangelogSectionId(err)
+		section, ok := sections[sectionId]
+		if !ok {
+			section = &ChangelogSection{
+				Id:    sectionId,
+				Title: l.Get("messages.changelog-" + sectionId),
+			}
+			sections[sectionId] = section
+			endpoints[sectionId] = map[diff.Endpoint]*ChangelogEndpoint{}
+		}
+
+		key := diff.Endpoint{Method: err.Operation, Path: err.Path}
+		endpoint, ok := endpoints[sectionId][key]
+		if !ok {
+			endpoint = newChangelogEndpoint(d, err)
+			endpoints[sectionId][key] = endpoint
+			section.Endpoints = append(section.Endpoints, endpoint)
+		}
+
+		endpoint.Changes = append(endpoint.Changes, &ChangelogChange{
+			Id:      err.Id,
+			Level:   getLevelName(err.Level),
+			Text:    err.UncolorizedText(),
+			Comment: err.Comment,
+		})
+	}
+
+	result := Changelog{
+		Title: l.Get("messages.changelog-title"),
+	}
+	for _, sectionId := range changelogSectionIds {
+		if section, ok := sections[sectionId]; ok {
+			section.sort()
+			result.Sections = append(result.Sections, section)
+		}
+	}
+	return &result
+}
+
+func getChangelogSectionId(err checker.BackwardCompatibilityError) string {
+	switch {
+	case err.Level == checker.ERR:
+		return ChangelogBreakingChanges
+	case err.Level == checker.WARN:
+		return ChangelogWarnings
+	case strings.HasSuffix(err.Id, "-deprecated"):
+		return ChangelogDeprecations
+	case strings.HasSuffix(err.Id, "-added"):
+		return ChangelogAdditions
+	default:
+		return ChangelogOtherChanges
+	}
+}
+
+func getLevelName(level checker.Level) string {
+	switch level {
+	case checker.ERR:
+		return "error"
+	case checker.WARN:
+		return "warning"
+	case checker.INFO:
+		return "info"
+	default:
+		return "issue"
+	}
+}
+
+func newChangelogEndpoint(d *diff.Diff, err checker.BackwardCompatibilityError) *ChangelogEndpoint {
+	result := ChangelogEndpoint{
+		Operation:   err.Operation,
+		Path:        err.Path,
+		OperationId: err.OperationId,
+	}
+
+	operation := getChangelogOperation(d, err.Operation, err.Path)
+	if operation == nil || !operation.Deprecated {
+		return &result
+	}
+
+	result.Deprecated = true
+	if sunset, _, _ := diff.GetSunsetDate(operation.Extensions); sunset != "" {
+		result.Sunset = sunset
+	}
+	return &result
+}
+
+// getChangelogOperation returns the operation in the revision, or in the base if it was deleted
+func getChangelogOperation(d *diff.Diff, method, path string) *openapi3.Operation {
+	if d == nil || d.PathsDiff == nil {
+		return nil
+	}
+
+	for _, paths := range []openapi3.Paths{d.PathsDiff.Revision, d.PathsDiff.Base} {
+		if pathItem := paths[path]; pathItem != nil {
+			if operation := pathItem.GetOperation(method); operation != nil {
+				return operation
+			}
+		}
+	}
+	return nil
+}
+
+func (section *ChangelogSection) sort() {
+	sort.SliceStable(section.Endpoints, func(i, j int) bool {
+		iv, jv := section.Endpoints[i], section.Endpoints[j]
+		if iv.Path != jv.Path {
+			return iv.Path < jv.Path
+		}
+		return iv.Operation < jv.Operation
+	})
+
+	levels := map[string]int{"error": 0, "warning": 1, "info": 2}
+	for _, endpoint := range section.Endpoints {
+		sort.SliceStable(endpoint.Changes, func(i, j int) bool {
+			iv, jv := endpoint.Changes[i], endpoint.Changes[j]
+			if iv.Level != jv.Level {
+				return levels[iv.Level] < levels[jv.Level]
+			}
+			return iv.Id < jv.Id
+		})
+	}
+}
+
+// GetChangelogMarkdown returns the changelog as GitHub-flavored markdown
+func GetChangelogMarkdown(changelog *Changelog, l localizations.Localizer) (string, error) {
+	return executeTemplate("changelog", defaultChangelogTemplate, changelog, nil, l, true)
+}
+
+// GetChangelogHTML returns the changelog as HTML, rendered from the markdown changelog
+func GetChangelogHTML(changelog *Changelog, l localizations.Localizer) (string, error) {
+	output, err := GetChangelogMarkdown(changelog, l)
+	if err != nil {
+		return "", err
+	}
+
+	return markdownToHTML([]byte(output))
+}
@@ -0,0 +1,74 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/checker/localizations"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/report"
+)
+
+func getChangelog(t *testing.T) *report.Changelog {
+	t.Helper()
+
+	s1, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), "../data/changelog/base.yaml")
+	require.NoError(t, err)
+	s2, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), "../data/changelog/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig().WithCheckBreaking(), s1, s2)
+	require.NoError(t, err)
+
+	errs := checker.CheckBackwardCompatibilityUntilLevel(checker.GetDefaultChecks(), d, osm, checker.INFO)
+	return report.GetChangelog(errs, d, *localizations.New("en", "en"))
+}
+
+func Test_Changelog(t *testing.T) {
+	changelog := getChangelog(t)
+
+	require.Equal(t, "API Changelog", changelog.Title)
+	require.Len(t, changelog.Sections, 4)
+
+	breaking := changelog.Sections[0]
+	require.Equal(t, report.ChangelogBreakingChanges, breaking.Id)
+	require.Equal(t, "Breaking Changes", breaking.Title)
+	require.Len(t, breaking.Endpoints, 1)
+	require.Equal(t, "GET /pets/{id}", breaking.Endpoints[0].Name())
+	require.Equal(t, "error", breaking.Endpoints[0].Changes[0].Level)
+	require.Equal(t, "added the new required 'query' request parameter 'fields'", breaking.Endpoints[0].Changes[0].Text)
+
+	require.Equal(t, report.ChangelogWarnings, changelog.Sections[1].Id)
+
+	deprecations := changelog.Sections[2]
+	require.Equal(t, report.ChangelogDeprecations, deprecations.Id)
+	require.True(t, deprecations.Endpoints[0].Deprecated)
+	require.Equal(t, "2030-01-01", deprecations.Endpoints[0].Sunset)
+
+	additions := changelog.Sections[3]
+	require.Equal(t, report.ChangelogAdditions, additions.Id)
+	require.Equal(t, "POST /pets", additions.Endpoints[0].Name())
+}
+
+func Test_ChangelogMarkdown(t *testing.T) {
+	markdown, err := report.GetChangelogMarkdown(getChangelog(t), *localizations.New("en", "en"))
+	require.NoError(t, err)
+	require.Contains(t, markdown, "## Breaking Changes\n\n### GET /pets/{id}\n\n- added the new required 'query' request parameter 'fields'\n")
+	require.Contains(t, markdown, "### GET /stores\n\n_deprecated, sunset date 2030-01-01_\n\n- endpoint deprecated\n")
+}
+
+func Test_ChangelogMarkdown_NoChanges(t *testing.T) {
+	markdown, err := report.GetChangelogMarkdown(report.GetChangelog(nil, nil, *localizations.New("en", "en")), *localizations.New("en", "en"))
+	require.NoError(t, err)
+	require.Equal(t, "# API Changelog\n\nNo changes\n", markdown)
+}
+
+func Test_ChangelogHTML(t *testing.T) {
+	html, err := report.GetChangelogHTML(getChangelog(t), *localizations.New("en", "en"))
+	require.NoError(t, err)
+	require.Contains(t, html, "<h2 id=\"breaking-changes\">Breaking Changes</h2>")
+	require.Contains(t, html, "<em>deprecated, sunset date 2030-01-01</em>")
+}
@@ -1,5 +1,5 @@
 /*
-Package report generates OpenAPI Spec diff reports as text and HTML.
+Package report generates OpenAPI Spec diff reports as text and HTML, breaking-changes reports as SARIF, changelogs as markdown and HTML, and custom reports from Go templates.
 Note that the reports only display the common kinds of changes.
 For a comprehensive diff report, view the YAML output of the diff.
 */
@@ -14,6 +14,10 @@ type DiffT interface {
 		*diff.ResponseDiff |
 		*diff.MediaTypeDiff |
 		*diff.HeaderDiff |
+		*diff.PathDiff |
+		*diff.MethodDiff |
+		*diff.RenamedOperationDiff |
+		*diff.PathRewrite |
 		diff.SecurityScopesDiff |
 		*diff.StringsDiff
 }
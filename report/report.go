@@ -57,6 +57,18 @@ func (r *report) output(d *diff.Diff) {
 		r.printEndpoints(d.EndpointsDiff)
 	}
 
+	if !d.RenamedOperationsDiff.Empty() {
+		r.printRenamedOperations(*d.RenamedOperationsDiff)
+	}
+
+	if !d.PathRewrites.Empty() {
+		r.printPathRewrites(*d.PathRewrites)
+	}
+
+	if !d.WebhooksDiff.Empty() {
+		r.printWebhooks(d.WebhooksDiff)
+	}
+
 	if !d.SecurityDiff.Empty() {
 		r.print("Security Requirements changed")
 		r.indent().printSecurityRequirements(d.SecurityDiff)
@@ -96,6 +108,80 @@ func (r *report) printEndpoints(d *diff.EndpointsDiff) {
 	}
 }
 
+func (r *report) printRenamedOperations(d diff.RenamedOperationsDiff) {
+
+	r.printTitle("Renamed Endpoints", len(d))
+	for _, operationId := range getKeys(d) {
+		renamed := d[operationId]
+		r.print(renamed.Base.Method, renamed.Base.Path, "->", renamed.Revision.Method, renamed.Revision.Path, "(operationId:", operationId+")")
+		if !renamed.MethodDiff.Empty() {
+			r.indent().printMethod(renamed.MethodDiff)
+		}
+		r.print("")
+	}
+}
+
+func (r *report) printPathRewrites(d diff.PathRewrites) {
+
+	r.printTitle("Rewritten Paths", len(d))
+	for _, path := range getKeys(d) {
+		pathRewrite := d[path]
+		r.print(path)
+		if pathRewrite.Base != nil {
+			r.indent().print("Base path:", pathRewrite.Base.Path, "(rule: "+pathRewrite.Base.Rule+")")
+		}
+		if pathRewrite.Revision != nil {
+			r.indent().print("Revision path:", pathRewrite.Revision.Path, "(rule: "+pathRewrite.Revision.Rule+")")
+		}
+	}
+	r.print("")
+}
+
+func (r *report) printWebhooks(d *diff.PathsDiff) {
+
+	r.printTitle("New Webhooks", len(d.Added))
+	sort.Sort(d.Added)
+	for _, added := range d.Added {
+		r.print(added)
+	}
+	r.print("")
+
+	r.printTitle("Deleted Webhooks", len(d.Deleted))
+	sort.Sort(d.Deleted)
+	for _, deleted := range d.Deleted {
+		r.print(deleted)
+	}
+	r.print("")
+
+	r.printTitle("Modified Webhooks", len(d.Modified))
+	for _, name := range getKeys(d.Modified) {
+		r.print(name)
+		r.indent().printWebhookOperations(d.Modified[name].OperationsDiff)
+		r.print("")
+	}
+}
+
+func (r *report) printWebhookOperations(d *diff.OperationsDiff) {
+	if d.Empty() {
+		return
+	}
+
+	sort.Sort(d.Added)
+	for _, added := range d.Added {
+		r.print("New operation:", added)
+	}
+
+	sort.Sort(d.Deleted)
+	for _, deleted := range d.Deleted {
+		r.print("Deleted operation:", deleted)
+	}
+
+	for _, operation := range getKeys(d.Modified) {
+		r.print("Modified operation:", operation)
+		r.indent().printMethod(d.Modified[operation])
+	}
+}
+
 func (r *report) printServers(d *diff.ServersDiff) {
 	if d.Empty() {
 		return
@@ -170,6 +256,13 @@ func (r *report) printParams(d *diff.ParametersDiff) {
 		}
 	}
 
+	for _, renamed := range d.Renamed {
+		r.print("Renamed", renamed.Base.In, "param:", renamed.Base.Name, "to", renamed.Revision.In, "param:", renamed.Revision.Name)
+		if !renamed.ParameterDiff.Empty() {
+			r.indent().printParam(renamed.ParameterDiff)
+		}
+	}
+
 	for _, location := range diff.ParamLocations {
 		paramDiffs := d.Modified[location]
 		for _, param := range getKeys(paramDiffs) {
@@ -0,0 +1,200 @@
+package report
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	sarifVersion        = "2.1.0"
+	sarifSchema         = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolName       = "oasdiff"
+	sarifInformationUri = "https://github.com/Tufin/oasdiff"
+)
+
+// SarifLog is the root object of a SARIF 2.1.0 report, see: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type SarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationUri string      `json:"informationUri,omitempty"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+type SarifRule struct {
+	Id                   string                      `json:"id"`
+	ShortDescription     *SarifMessage               `json:"shortDescription,omitempty"`
+	DefaultConfiguration SarifReportingConfiguration `json:"defaultConfiguration"`
+}
+
+type SarifReportingConfiguration struct {
+	Level string `json:"level"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifResult struct {
+	RuleId           string          `json:"ruleId"`
+	RuleIndex        int             `json:"ruleIndex"`
+	Level            string          `json:"level"`
+	Message          SarifMessage    `json:"message"`
+	Locations        []SarifLocation `json:"locations,omitempty"`
+	RelatedLocations []SarifLocation `json:"relatedLocations,omitempty"`
+}
+
+type SarifLocation struct {
+	Id               *int                   `json:"id,omitempty"`
+	Message          *SarifMessage          `json:"message,omitempty"`
+	PhysicalLocation *SarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []SarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+type SarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type SarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+type SarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+}
+
+// GetSarifReport returns a SARIF report of the breaking changes
+// rules are listed in the tool driver section, errors with an unlisted rule id get a rule of their own
+func GetSarifReport(errs checker.BackwardCompatibilityErrors, rules []checker.BackwardCompatibilityRule, version string) *SarifLog {
+	driver := SarifDriver{
+		Name:           sarifToolName,
+		Version:        version,
+		InformationUri: sarifInformationUri,
+		Rules:          make([]SarifRule, 0, len(rules)),
+	}
+
+	ruleIndex := map[string]int{}
+	for _, rule := range rules {
+		ruleIndex[rule.Id] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, SarifRule{
+			Id:                   rule.Id,
+			ShortDescription:     &SarifMessage{Text: rule.Description},
+			DefaultConfiguration: SarifReportingConfiguration{Level: sarifLevel(rule.Level)},
+		})
+	}
+
+	results := make([]SarifResult, 0, len(errs))
+	for _, bcErr := range errs {
+		index, ok := ruleIndex[bcErr.Id]
+		if !ok {
+			index = len(driver.Rules)
+			ruleIndex[bcErr.Id] = index
+			driver.Rules = append(driver.Rules, SarifRule{
+				Id:                   bcErr.Id,
+				DefaultConfiguration: SarifReportingConfiguration{Level: sarifLevel(bcErr.Level)},
+			})
+		}
+
+		results = append(results, SarifResult{
+			RuleId:           bcErr.Id,
+			RuleIndex:        index,
+			Level:            sarifLevel(bcErr.Level),
+			Message:          SarifMessage{Text: bcErr.UncolorizedText()},
+			Locations:        []SarifLocation{sarifLocation(bcErr)},
+			RelatedLocations: sarifRelatedLocations(bcErr),
+		})
+	}
+
+	return &SarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []SarifRun{{
+			Tool:    SarifTool{Driver: driver},
+			Results: results,
+		}},
+	}
+}
+
+func sarifLevel(level checker.Level) string {
+	switch level {
+	case checker.ERR:
+		return "error"
+	case checker.WARN:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// sarifLocation returns the location of the change in the revision, or in the base if the element was deleted
+func sarifLocation(bcErr checker.BackwardCompatibilityError) SarifLocation {
+	result := SarifLocation{}
+
+	switch {
+	case bcErr.RevisionSource != nil:
+		result.PhysicalLocation = sarifPhysicalLocation(*bcErr.RevisionSource)
+	case bcErr.BaseSource != nil:
+		result.PhysicalLocation = sarifPhysicalLocation(*bcErr.BaseSource)
+	case bcErr.Source != "":
+		result.PhysicalLocation = &SarifPhysicalLocation{
+			ArtifactLocation: SarifArtifactLocation{Uri: filepath.ToSlash(bcErr.Source)},
+		}
+	}
+
+	if endpoint := strings.TrimSpace(bcErr.Operation + " " + bcErr.Path); endpoint != "" {
+		result.LogicalLocations = []SarifLogicalLocation{{
+			Name:               endpoint,
+			FullyQualifiedName: endpoint,
+		}}
+	}
+
+	return result
+}
+
+// sarifRelatedLocations returns the base location of the change when both the base and the revision locations are known
+func sarifRelatedLocations(bcErr checker.BackwardCompatibilityError) []SarifLocation {
+	if bcErr.BaseSource == nil || bcErr.RevisionSource == nil {
+		return nil
+	}
+
+	id := 1
+	return []SarifLocation{{
+		Id:               &id,
+		Message:          &SarifMessage{Text: "base"},
+		PhysicalLocation: sarifPhysicalLocation(*bcErr.BaseSource),
+	}}
+}
+
+func sarifPhysicalLocation(location load.Location) *SarifPhysicalLocation {
+	return &SarifPhysicalLocation{
+		ArtifactLocation: SarifArtifactLocation{Uri: filepath.ToSlash(location.File)},
+		Region: &SarifRegion{
+			StartLine:   location.Line,
+			StartColumn: location.Column,
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/report"
+)
+
+func Test_Sarif(t *testing.T) {
+	errs := checker.BackwardCompatibilityErrors{
+		{
+			Id:        "request-parameter-removed",
+			Level:     checker.WARN,
+			Text:      "deleted the 'query' request parameter 'id'",
+			Operation: "GET",
+			Path:      "/api/test",
+			Source:    "openapi.yaml",
+		},
+		{
+			Id:    "unknown-check",
+			Level: checker.INFO,
+			Text:  "some change",
+		},
+	}
+
+	sarif := report.GetSarifReport(errs, checker.GetAllRules(), "1.0.0")
+	require.Equal(t, "2.1.0", sarif.Version)
+	require.Len(t, sarif.Runs, 1)
+
+	driver := sarif.Runs[0].Tool.Driver
+	require.Equal(t, "oasdiff", driver.Name)
+	require.Len(t, driver.Rules, len(checker.GetAllRules())+1)
+
+	results := sarif.Runs[0].Results
+	require.Len(t, results, 2)
+	require.Equal(t, "request-parameter-removed", results[0].RuleId)
+	require.Equal(t, "request-parameter-removed", driver.Rules[results[0].RuleIndex].Id)
+	require.Equal(t, "warning", results[0].Level)
+	require.Equal(t, "deleted the 'query' request parameter 'id'", results[0].Message.Text)
+	require.Equal(t, "openapi.yaml", results[0].Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	require.Equal(t, "GET /api/test", results[0].Locations[0].LogicalLocations[0].Name)
+
+	require.Equal(t, "unknown-check", driver.Rules[results[1].RuleIndex].Id)
+	require.Equal(t, "note", results[1].Level)
+	require.Nil(t, results[1].Locations[0].PhysicalLocation)
+}
+
+func Test_SarifLocations(t *testing.T) {
+	errs := checker.BackwardCompatibilityErrors{
+		{
+			Id:             "request-parameter-removed",
+			Level:          checker.ERR,
+			Operation:      "GET",
+			Path:           "/api/test",
+			Source:         "revision.yaml",
+			BaseSource:     &load.Location{File: "base.yaml", Line: 10, Column: 5},
+			RevisionSource: &load.Location{File: "revision.yaml", Line: 12, Column: 5},
+		},
+		{
+			Id:         "api-removed-without-deprecation",
+			Level:      checker.ERR,
+			Operation:  "GET",
+			Path:       "/api/test",
+			Source:     "base.yaml",
+			BaseSource: &load.Location{File: "base.yaml", Line: 10, Column: 5},
+		},
+	}
+
+	results := report.GetSarifReport(errs, checker.GetAllRules(), "1.0.0").Runs[0].Results
+	require.Len(t, results, 2)
+
+	require.Equal(t, "revision.yaml", results[0].Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	require.Equal(t, &report.SarifRegion{StartLine: 12, StartColumn: 5}, results[0].Locations[0].PhysicalLocation.Region)
+	require.Len(t, results[0].RelatedLocations, 1)
+	require.Equal(t, "base.yaml", results[0].RelatedLocations[0].PhysicalLocation.ArtifactLocation.Uri)
+
+	require.Equal(t, "base.yaml", results[1].Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	require.Equal(t, &report.SarifRegion{StartLine: 10, StartColumn: 5}, results[1].Locations[0].PhysicalLocation.Region)
+	require.Empty(t, results[1].RelatedLocations)
+}
@@ -0,0 +1,139 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/TwiN/go-color"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/checker/localizations"
+	"github.com/tufin/oasdiff/diff"
+)
+
+/*
+TemplateData is the data model which is passed to custom report templates, see GetTemplateReport
+The fields of the embedded Changelog, like .Title and .Sections, are available only when the changes were checked
+*/
+type TemplateData struct {
+	*Changelog                                     // the changes grouped by kind and by endpoint
+	Diff       *diff.Diff                          // the diff between the specs
+	Summary    *diff.Summary                       // the summary of the diff
+	Changes    checker.BackwardCompatibilityErrors // the changes found by the checks, ordered by level, path and operation
+}
+
+// NewTemplateData returns the data model of a diff report
+func NewTemplateData(d *diff.Diff) *TemplateData {
+	return &TemplateData{
+		Diff:    d,
+		Summary: d.GetSummary(),
+	}
+}
+
+// NewChangesTemplateData returns the data model of a report of the changes found by the checks
+func NewChangesTemplateData(d *diff.Diff, errs checker.BackwardCompatibilityErrors, l localizations.Localizer) *TemplateData {
+	result := NewTemplateData(d)
+	result.Changelog = GetChangelog(errs, d, l)
+	result.Changes = errs
+	return result
+}
+
+var templateColors = map[string]string{
+	"red":    color.Red,
+	"green":  color.Green,
+	"yellow": color.Yellow,
+	"blue":   color.Blue,
+	"purple": color.Purple,
+	"cyan":   color.Cyan,
+	"gray":   color.Gray,
+	"white":  color.White,
+	"bold":   color.Bold,
+}
+
+/*
+getTemplateFuncs returns the functions which are available in templates:
+- colorize: colorize "red" value, displays a value in red, green, yellow, blue, purple, cyan, gray, white or bold, unless the output is piped or colors are disabled
+- uncolorize: removes the colors from a text, like the values in the text of the changes
+- level: the name of a change level: error, warning or info
+- localize: localize "key" args..., formats a localized message, see checker/localizations_src
+- groupByPath: groups changes by path
+- groupByTag: groups changes by the tags of their operations, changes in operations without tags are grouped under an empty tag
+*/
+func getTemplateFuncs(d *diff.Diff, l localizations.Localizer, colors bool) template.FuncMap {
+	return template.FuncMap{
+		"colorize": func(name string, value interface{}) (string, error) {
+			c, ok := templateColors[name]
+			if !ok {
+				return "", fmt.Errorf("unknown color %q", name)
+			}
+			if !colors || checker.IsPipedOutput() {
+				return fmt.Sprint(value), nil
+			}
+			return color.Colorize(c, value), nil
+		},
+		"uncolorize": func(text string) string {
+			return (&checker.BackwardCompatibilityError{Text: text}).UncolorizedText()
+		},
+		"level": getLevelName,
+		"localize": func(key string, args ...interface{}) string {
+			return fmt.Sprintf(l.Get("messages."+key), args...)
+		},
+		"groupByPath": groupByPath,
+		"groupByTag": func(errs checker.BackwardCompatibilityErrors) map[string]checker.BackwardCompatibilityErrors {
+			return groupByTag(d, errs)
+		},
+	}
+}
+
+func groupByPath(errs checker.BackwardCompatibilityErrors) map[string]checker.BackwardCompatibilityErrors {
+	result := map[string]checker.BackwardCompatibilityErrors{}
+	for _, err := range errs {
+		result[err.Path] = append(result[err.Path], err)
+	}
+	return result
+}
+
+// groupByTag groups changes by the tags of their operations, a change in an operation with several tags appears under each tag
+func groupByTag(d *diff.Diff, errs checker.BackwardCompatibilityErrors) map[string]checker.BackwardCompatibilityErrors {
+	result := map[string]checker.BackwardCompatibilityErrors{}
+	for _, err := range errs {
+		operation := getChangelogOperation(d, err.Operation, err.Path)
+		if operation == nil || len(operation.Tags) == 0 {
+			result[""] = append(result[""], err)
+			continue
+		}
+		for _, tag := range operation.Tags {
+			result[tag] = append(result[tag], err)
+		}
+	}
+	return result
+}
+
+func executeTemplate(name, templateText string, data interface{}, d *diff.Diff, l localizations.Localizer, colors bool) (string, error) {
+	tmpl, err := template.New(name).Funcs(getTemplateFuncs(d, l, colors)).Parse(templateText)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// GetTemplateReport executes a custom Go template, see TemplateData for the data and getTemplateFuncs for the functions which are available in the template
+func GetTemplateReport(templateText string, data *TemplateData, l localizations.Localizer) (string, error) {
+	return executeTemplate("custom", templateText, data, data.Diff, l, true)
+}
+
+// GetTemplateHTML executes a custom Go template like GetTemplateReport and renders its output, as markdown, to HTML
+// colorize doesn't add colors to the output, since they can't be rendered in HTML
+func GetTemplateHTML(templateText string, data *TemplateData, l localizations.Localizer) (string, error) {
+	output, err := executeTemplate("custom", templateText, data, data.Diff, l, false)
+	if err != nil {
+		return "", err
+	}
+
+	return markdownToHTML([]byte(output))
+}
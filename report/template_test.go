@@ -0,0 +1,95 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/checker/localizations"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/report"
+)
+
+func getTemplateData(t *testing.T) *report.TemplateData {
+	t.Helper()
+
+	s1, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), "../data/changelog/base.yaml")
+	require.NoError(t, err)
+	s2, err := load.LoadSpecInfoFromFile(openapi3.NewLoader(), "../data/changelog/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig().WithCheckBreaking(), s1, s2)
+	require.NoError(t, err)
+
+	errs := checker.CheckBackwardCompatibilityUntilLevel(checker.GetDefaultChecks(), d, osm, checker.INFO)
+	return report.NewChangesTemplateData(d, errs, *localizations.New("en", "en"))
+}
+
+func getTemplateReport(t *testing.T, templateText string, data *report.TemplateData) string {
+	t.Helper()
+
+	output, err := report.GetTemplateReport(templateText, data, *localizations.New("en", "en"))
+	require.NoError(t, err)
+	return output
+}
+
+func Test_TemplateChangelog(t *testing.T) {
+	const template = `{{range .Sections}}{{.Id}}:{{range .Endpoints}} {{.Name}}{{end}};{{end}}`
+	require.Equal(t, "breaking-changes: GET /pets/{id};warnings: GET /pets/{id};deprecations: GET /stores;additions: POST /pets;", getTemplateReport(t, template, getTemplateData(t)))
+}
+
+func Test_TemplateDiff(t *testing.T) {
+	const template = `{{range $endpoint, $methodDiff := .Diff.EndpointsDiff.Modified}}{{$endpoint.Method}} {{$endpoint.Path}};{{end}}`
+	require.Equal(t, "GET /pets/{id};GET /stores;", getTemplateReport(t, template, getTemplateData(t)))
+}
+
+func Test_TemplateSummary(t *testing.T) {
+	const template = `{{.Summary.Diff}} {{(.Summary.GetSummaryDetails "endpoints").Added}} {{(.Summary.GetSummaryDetails "endpoints").Modified}}`
+	require.Equal(t, "true 1 2", getTemplateReport(t, template, getTemplateData(t)))
+}
+
+func Test_TemplateDiffOnly(t *testing.T) {
+	data := getTemplateData(t)
+	data = report.NewTemplateData(data.Diff)
+
+	require.Equal(t, "0", getTemplateReport(t, `{{len .Changes}}`, data))
+
+	// the changelog is available only when the changes were checked
+	_, err := report.GetTemplateReport(`{{.Title}}`, data, *localizations.New("en", "en"))
+	require.ErrorContains(t, err, "failed to execute custom template")
+}
+
+func Test_TemplateGroupByPath(t *testing.T) {
+	const template = `{{range $path, $changes := groupByPath .Changes}}{{$path}}:{{range $changes}} {{.Id}}{{end}};{{end}}`
+	require.Equal(t, "/pets: endpoint-added;/pets/{id}: new-required-request-parameter request-parameter-pattern-added;/stores: endpoint-deprecated;", getTemplateReport(t, template, getTemplateData(t)))
+}
+
+func Test_TemplateGroupByTag(t *testing.T) {
+	const template = `{{range $tag, $changes := groupByTag .Changes}}{{$tag}}: {{len $changes}};{{end}}`
+	require.Equal(t, "inventory: 1;pets: 3;stores: 1;", getTemplateReport(t, template, getTemplateData(t)))
+}
+
+func Test_TemplateFuncs(t *testing.T) {
+	const template = `{{range .Changes}}{{if eq (level .Level) "error"}}{{colorize "red" (level .Level)}} {{uncolorize .Text}}{{end}}{{end}} {{localize "changelog-sunset" "2030-01-01"}}`
+	require.Equal(t, "error added the new required 'query' request parameter 'fields' sunset date 2030-01-01", getTemplateReport(t, template, getTemplateData(t)))
+}
+
+func Test_TemplateHTML(t *testing.T) {
+	output, err := report.GetTemplateHTML(`# {{.Title}}{{range .Sections}}
+- {{colorize "red" .Id}}{{end}}`, getTemplateData(t), *localizations.New("en", "en"))
+	require.NoError(t, err)
+	require.Contains(t, output, "API Changelog</h1>")
+	require.Contains(t, output, "<li>breaking-changes</li>")
+}
+
+func Test_TemplateUnknownColor(t *testing.T) {
+	_, err := report.GetTemplateReport(`{{colorize "pink" "text"}}`, getTemplateData(t), *localizations.New("en", "en"))
+	require.ErrorContains(t, err, "unknown color \"pink\"")
+}
+
+func Test_TemplateInvalid(t *testing.T) {
+	_, err := report.GetTemplateReport("{{range}}", getTemplateData(t), *localizations.New("en", "en"))
+	require.ErrorContains(t, err, "failed to parse custom template")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
 	"github.com/tufin/oasdiff/report"
 )
 
@@ -84,3 +85,63 @@ func TestText_DerefUint64Nil(t *testing.T) {
 	textReport := report.GetTextReportAsString(d(t, &diff.Config{}, 1, 5))
 	require.Contains(t, textReport, "MaxLength changed from 29 to null")
 }
+
+func TestText_Webhooks(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := load.LoadSpecInfoFromFile(loader, "../data/openapi31-test1.yaml")
+	require.NoError(t, err)
+
+	s2, err := load.LoadSpecInfoFromFile(loader, "../data/openapi31-test2.yaml")
+	require.NoError(t, err)
+
+	dd, _, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	textReport := report.GetTextReportAsString(dd)
+	require.Contains(t, textReport, "### Modified Webhooks: 1")
+	require.Contains(t, textReport, "- Modified operation: POST")
+	require.Contains(t, textReport, "New required property: tag")
+}
+
+func TestText_RenamedOperations(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/operation-ids/base.yaml")
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile("../data/operation-ids/revision.yaml")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.MatchOperationIds = true
+	dd, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+
+	textReport := report.GetTextReportAsString(dd)
+	require.Contains(t, textReport, "### Renamed Endpoints: 2")
+	require.Contains(t, textReport, "GET /v1/users/{id} -> GET /users/{userId} (operationId: getUser)")
+	require.Contains(t, textReport, "DELETE /v1/users/{id} -> POST /users/{userId}/remove (operationId: deleteUser)")
+}
+
+func TestText_PathRewrites(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/rewrite/base.yaml")
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile("../data/rewrite/revision.yaml")
+	require.NoError(t, err)
+
+	rules, err := diff.LoadRewriteRules("../data/rewrite/rules.yaml")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.PathRewriteRules = rules
+	dd, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+
+	textReport := report.GetTextReportAsString(dd)
+	require.Contains(t, textReport, "### Rewritten Paths: 2")
+	require.Contains(t, textReport, "- Base path: /api/v1/pets/{id} (rule: drop the version prefix)")
+}